@@ -1,22 +1,36 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/BurntSushi/toml"
+	"github.com/aabbuukkaarr8/PRService/db"
 	"github.com/aabbuukkaarr8/PRService/internal/apiserver"
+	ownersapi "github.com/aabbuukkaarr8/PRService/internal/handler/owners"
 	prapi "github.com/aabbuukkaarr8/PRService/internal/handler/pullrequest"
 	teamapi "github.com/aabbuukkaarr8/PRService/internal/handler/team"
 	userapi "github.com/aabbuukkaarr8/PRService/internal/handler/user"
+	"github.com/aabbuukkaarr8/PRService/internal/idempotency"
+	"github.com/aabbuukkaarr8/PRService/internal/migrate"
+	"github.com/aabbuukkaarr8/PRService/internal/ratelimit"
+	idempotencyrepo "github.com/aabbuukkaarr8/PRService/internal/repository/idempotency"
+	ownersrepo "github.com/aabbuukkaarr8/PRService/internal/repository/owners"
 	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
 	teamrepo "github.com/aabbuukkaarr8/PRService/internal/repository/team"
 	userrepo "github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
 	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
 	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
 	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"github.com/aabbuukkaarr8/PRService/internal/validation"
 )
 
 var (
@@ -29,41 +43,104 @@ func init() {
 
 func main() {
 	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context) error {
 	config := apiserver.NewConfig()
 	_, err := toml.DecodeFile(configPath, config)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	if dbURL := os.Getenv("DATABASE_URL"); dbURL != "" {
 		config.Store.DatabaseURL = dbURL
 	}
 
-	db := store.New()
-	err = db.Open(config.Store.DatabaseURL)
+	if err := validation.Configure(config.Validation); err != nil {
+		return err
+	}
+	if err := config.RateLimit.Validate(); err != nil {
+		return err
+	}
+
+	dbStore := store.New()
+	err = dbStore.Open(ctx, config.Store)
 	if err != nil {
-		log.Fatal(err)
-		return
+		return err
+	}
+
+	s := apiserver.New(config)
+	logger := s.GetLogger()
+
+	shutdownTracing, err := tracing.Setup(ctx, config.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("Failed to flush traces")
+		}
+	}()
+
+	defer func() {
+		if err := dbStore.Close(); err != nil {
+			logger.WithError(err).Error("Failed to close database connection")
+		}
+	}()
+
+	migrator, err := migrate.New(dbStore, db.Migrations())
+	if err != nil {
+		return err
+	}
+
+	if args := flag.Args(); len(args) > 0 {
+		if args[0] != "migrate" {
+			return fmt.Errorf("unknown command %q", args[0])
+		}
+		return runMigrate(ctx, migrator, args[1:])
+	}
+
+	if err := migrator.Check(ctx); err != nil {
+		return fmt.Errorf("%w: run 'apiserver migrate up' first", err)
 	}
 
-	teamRepo := teamrepo.NewRepository(db)
-	userRepo := userrepo.NewRepository(db)
-	prRepo := prrepo.NewRepository(db)
+	teamRepo := teamrepo.NewRepository(dbStore)
+	userRepo := userrepo.NewRepository(dbStore)
+	prRepo := prrepo.NewRepository(dbStore)
+	ownersRepo := ownersrepo.NewRepository(dbStore)
 
 	teamSrv := teamsrv.NewService(teamRepo)
 	userSrv := usersrv.NewService(userRepo)
-	prSrv := prsrv.NewService(prRepo)
-
-	s := apiserver.New(config)
-	logger := s.GetLogger()
+	ownersSrv := ownerssrv.NewService(ownersRepo)
+	prSrv := prsrv.NewService(prRepo, ownersSrv)
 
 	teamHandler := teamapi.NewHandler(teamSrv, logger)
 	userHandler := userapi.NewHandler(userSrv, logger)
 	prHandler := prapi.NewHandler(prSrv, logger)
+	ownersHandler := ownersapi.NewHandler(ownersSrv, logger)
 
-	s.ConfigureRouter(teamHandler, userHandler, prHandler)
-
-	if err := s.Run(); err != nil {
-		panic(err)
+	if config.RateLimit.Enabled {
+		limiter := ratelimit.NewMemoryLimiter()
+		s.Use(ratelimit.Middleware(limiter, config.RateLimit, logger))
+		s.Go(func(ctx context.Context) {
+			limiter.RunCleanup(ctx, config.RateLimit.CleanupInterval)
+		})
 	}
+
+	idempotencyRepo := idempotencyrepo.NewRepository(dbStore)
+	s.Use(idempotency.Middleware(idempotencyRepo, config.Idempotency.TTL, config.Idempotency.Lease, logger))
+	s.Go(func(ctx context.Context) {
+		idempotency.RunCleanup(ctx, idempotencyRepo, config.Idempotency.CleanupInterval, logger)
+	})
+
+	s.ConfigureRouter(teamHandler, userHandler, prHandler, ownersHandler)
+
+	return s.Run(ctx)
 }
@@ -0,0 +1,174 @@
+// prctl - консольная утилита для дежурных: управление командами, пользователями и PR
+// напрямую через сервисный слой (те же правила, что и у HTTP API).
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/BurntSushi/toml"
+	"github.com/aabbuukkaarr8/PRService/db"
+	"github.com/aabbuukkaarr8/PRService/internal/apiserver"
+	"github.com/aabbuukkaarr8/PRService/internal/migrate"
+	ownersrepo "github.com/aabbuukkaarr8/PRService/internal/repository/owners"
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	teamrepo "github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	userrepo "github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+)
+
+const usage = `usage: prctl [-config-path=...] [-o table|json] <command> <subcommand> [flags]
+
+commands:
+  team add -name NAME -member ID:USERNAME[:inactive][:lead] ...
+  team get -name NAME
+  team list [-prefix PREFIX] [-limit N] [-offset N]
+  team import -f FILE [-dry-run]
+  team add-members -name NAME -member ID:USERNAME[:inactive][:lead] ...
+  team remove-members -name NAME -ids USER_ID,...
+  team rename -name NAME -new-name NEW_NAME
+  team delete -name NAME
+  team deactivate -name NAME
+  user get -id USER_ID
+  user list [-team NAME] [-prefix PREFIX] [-active=true|false] [-limit N] [-offset N]
+  user update -id USER_ID -username USERNAME
+  user set-active -id USER_ID -active=true|false
+  user set-expertise -id USER_ID -tags TAG,...
+  user reviews -id USER_ID [-status OPEN,MERGED] [-order asc|desc] [-limit N] [-offset N]
+  pr create -id PR_ID -name TITLE -author USER_ID [-team NAME] [-files PATH,...] [-labels LABEL,...]
+            [-request USER_ID,...] [-exclude USER_ID,...]
+  pr merge -id PR_ID [-version N]
+  pr reassign -id PR_ID -old USER_ID [-new USER_ID] [-version N]
+  pr add-reviewer -id PR_ID -user USER_ID [-version N]
+  pr remove-reviewer -id PR_ID -user USER_ID [-version N]
+  pr show -id PR_ID
+  pr list [-author USER_ID] [-reviewer USER_ID] [-team NAME] [-status OPEN|MERGED] [-sort created_at|pull_request_id] [-order asc|desc] [-limit N] [-cursor CURSOR]
+  stats
+`
+
+var errUsage = errors.New("invalid usage")
+
+var (
+	configPath string
+	output     string
+)
+
+func init() {
+	flag.StringVar(&configPath, "config-path", "configs/apiserver.toml", "path to config file")
+	flag.StringVar(&output, "o", "table", "output format: table or json")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+}
+
+type app struct {
+	teams *teamsrv.Service
+	users *usersrv.Service
+	prs   *prsrv.Service
+	out   *printer
+}
+
+func main() {
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	err := run(ctx, flag.Args())
+	stop()
+
+	if err != nil {
+		if errors.Is(err, errUsage) {
+			flag.Usage()
+			os.Exit(2)
+		}
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	out, err := newPrinter(os.Stdout, output)
+	if err != nil {
+		return err
+	}
+
+	config := apiserver.NewConfig()
+	if _, err := toml.DecodeFile(configPath, config); err != nil {
+		return err
+	}
+	if dbURL := os.Getenv("DATABASE_URL"); dbURL != "" {
+		config.Store.DatabaseURL = dbURL
+	}
+	// CLI не должна долго ждать недоступную БД
+	config.Store.ConnectAttempts = 1
+
+	dbStore := store.New()
+	if err := dbStore.Open(ctx, config.Store); err != nil {
+		return err
+	}
+	defer dbStore.Close()
+
+	migrator, err := migrate.New(dbStore, db.Migrations())
+	if err != nil {
+		return err
+	}
+	if err := migrator.Check(ctx); err != nil {
+		return err
+	}
+
+	a := &app{
+		teams: teamsrv.NewService(teamrepo.NewRepository(dbStore)),
+		users: usersrv.NewService(userrepo.NewRepository(dbStore)),
+		prs:   prsrv.NewService(prrepo.NewRepository(dbStore), ownerssrv.NewService(ownersrepo.NewRepository(dbStore))),
+		out:   out,
+	}
+
+	switch args[0] {
+	case "team":
+		return a.team(ctx, args[1:])
+	case "user":
+		return a.user(ctx, args[1:])
+	case "pr":
+		return a.pr(ctx, args[1:])
+	case "stats":
+		return a.stats(ctx)
+	default:
+		return errUsage
+	}
+}
+
+// parseFlags разбирает флаги подкоманды и проверяет обязательные строковые флаги
+func parseFlags(fs *flag.FlagSet, args []string, required ...string) error {
+	fs.SetOutput(os.Stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	for _, name := range required {
+		if fs.Lookup(name).Value.String() == "" {
+			return fmt.Errorf("flag -%s is required", name)
+		}
+	}
+	return nil
+}
+
+// splitList разбирает значение флага вида "a,b,c"; пустое значение - пустой список
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
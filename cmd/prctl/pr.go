@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+
+	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
+)
+
+type pullRequestOutput struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	TeamName          string     `json:"team_name,omitempty"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	Version           int64      `json:"version"`
+	ReplacedBy        string     `json:"replaced_by,omitempty"`
+}
+
+type reviewerStatsOutput struct {
+	UserID           string `json:"user_id"`
+	Username         string `json:"username"`
+	TeamName         string `json:"team_name"`
+	AssignmentsCount int    `json:"assignments_count"`
+}
+
+type statsOutput struct {
+	TotalPRs      int                   `json:"total_prs"`
+	OpenPRs       int                   `json:"open_prs"`
+	MergedPRs     int                   `json:"merged_prs"`
+	ReviewerStats []reviewerStatsOutput `json:"reviewer_stats"`
+}
+
+func (a *app) pr(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		name := fs.String("name", "", "pull request name")
+		author := fs.String("author", "", "author user id")
+		team := fs.String("team", "", "team the pull request is filed against (required if the author is in several teams)")
+		files := fs.String("files", "", "comma-separated changed file paths, used to find code owners")
+		labels := fs.String("labels", "", "comma-separated labels, matched against reviewers' expertise")
+		request := fs.String("request", "", "comma-separated user ids to assign as reviewers first")
+		exclude := fs.String("exclude", "", "comma-separated user ids that must not be assigned")
+		if err := parseFlags(fs, args[1:], "id", "name", "author"); err != nil {
+			return err
+		}
+
+		result, err := a.prs.CreatePullRequest(ctx, prsrv.CreatePullRequest{
+			AuthorId:           *author,
+			PullRequestId:      *id,
+			PullRequestName:    *name,
+			TeamName:           *team,
+			Files:              splitList(*files),
+			Labels:             splitList(*labels),
+			RequestedReviewers: splitList(*request),
+			ExcludedReviewers:  splitList(*exclude),
+		})
+		if err != nil {
+			return err
+		}
+		return a.printPullRequest(result, "")
+	case "merge":
+		fs := flag.NewFlagSet("pr merge", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		version := fs.Int64("version", 0, "expected PR version (0 - no check)")
+		if err := parseFlags(fs, args[1:], "id"); err != nil {
+			return err
+		}
+
+		result, err := a.prs.MergePullRequest(ctx, *id, *version)
+		if err != nil {
+			return err
+		}
+		return a.printPullRequest(result, "")
+	case "reassign":
+		fs := flag.NewFlagSet("pr reassign", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		old := fs.String("old", "", "reviewer user id to replace")
+		newReviewer := fs.String("new", "", "replacement user id (random team member if empty)")
+		version := fs.Int64("version", 0, "expected PR version (0 - no check)")
+		if err := parseFlags(fs, args[1:], "id", "old"); err != nil {
+			return err
+		}
+
+		if *newReviewer != "" {
+			result, err := a.prs.ReassignReviewerTo(ctx, *id, *old, *newReviewer, *version)
+			if err != nil {
+				return err
+			}
+			return a.printPullRequest(result, *newReviewer)
+		}
+
+		result, replacedBy, err := a.prs.ReassignReviewer(ctx, *id, *old, *version)
+		if err != nil {
+			return err
+		}
+		return a.printPullRequest(result, replacedBy)
+	case "add-reviewer":
+		fs := flag.NewFlagSet("pr add-reviewer", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		userID := fs.String("user", "", "reviewer user id")
+		version := fs.Int64("version", 0, "expected PR version (0 - no check)")
+		if err := parseFlags(fs, args[1:], "id", "user"); err != nil {
+			return err
+		}
+
+		result, err := a.prs.AddReviewer(ctx, *id, *userID, *version)
+		if err != nil {
+			return err
+		}
+		return a.printPullRequest(result, "")
+	case "remove-reviewer":
+		fs := flag.NewFlagSet("pr remove-reviewer", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		userID := fs.String("user", "", "reviewer user id")
+		version := fs.Int64("version", 0, "expected PR version (0 - no check)")
+		if err := parseFlags(fs, args[1:], "id", "user"); err != nil {
+			return err
+		}
+
+		result, err := a.prs.RemoveReviewer(ctx, *id, *userID, *version)
+		if err != nil {
+			return err
+		}
+		return a.printPullRequest(result, "")
+	case "show":
+		fs := flag.NewFlagSet("pr show", flag.ContinueOnError)
+		id := fs.String("id", "", "pull request id")
+		if err := parseFlags(fs, args[1:], "id"); err != nil {
+			return err
+		}
+
+		result, err := a.prs.GetPullRequest(ctx, *id)
+		if err != nil {
+			return err
+		}
+		return a.printPullRequest(result, "")
+	case "list":
+		fs := flag.NewFlagSet("pr list", flag.ContinueOnError)
+		author := fs.String("author", "", "only pull requests by the author")
+		reviewer := fs.String("reviewer", "", "only pull requests assigned to the reviewer")
+		team := fs.String("team", "", "only pull requests of the team")
+		status := fs.String("status", "", "OPEN or MERGED")
+		sort := fs.String("sort", prsrv.SortCreatedAt, "created_at or pull_request_id")
+		order := fs.String("order", prsrv.OrderDesc, "asc or desc")
+		limit := fs.Int("limit", prsrv.DefaultListLimit, "page size")
+		cursor := fs.String("cursor", "", "next cursor printed by the previous page")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+
+		result, err := a.prs.ListPullRequests(ctx, prsrv.ListPullRequestsParams{
+			AuthorID:   *author,
+			ReviewerID: *reviewer,
+			TeamName:   *team,
+			Status:     *status,
+			Sort:       *sort,
+			Order:      *order,
+			Limit:      *limit,
+			Cursor:     *cursor,
+		})
+		if err != nil {
+			return err
+		}
+
+		out := make([]pullRequestOutput, len(result.PullRequests))
+		t := table{header: []string{"PULL REQUEST", "NAME", "AUTHOR", "TEAM", "STATUS", "REVIEWERS", "CREATED AT"}}
+		for i, pr := range result.PullRequests {
+			out[i] = toPullRequestOutput(pr.PullRequest)
+			createdAt := ""
+			if pr.CreatedAt != nil {
+				createdAt = pr.CreatedAt.Format(time.RFC3339)
+			}
+			t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.TeamName, pr.Status, strings.Join(pr.AssignedReviewers, ","), createdAt)
+		}
+		if result.NextCursor != "" {
+			a.out.note("next page: -cursor %s\n\n", result.NextCursor)
+		}
+		return a.out.print(out, t)
+	default:
+		return errUsage
+	}
+}
+
+func toPullRequestOutput(pr prsrv.PullRequest) pullRequestOutput {
+	return pullRequestOutput{
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		TeamName:          pr.TeamName,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		Version:           pr.Version,
+	}
+}
+
+func (a *app) printPullRequest(pr prsrv.PullRequest, replacedBy string) error {
+	out := toPullRequestOutput(pr)
+	out.ReplacedBy = replacedBy
+
+	t := table{header: []string{"PULL REQUEST", "NAME", "AUTHOR", "TEAM", "STATUS", "REVIEWERS", "CREATED AT"}}
+	createdAt := ""
+	if pr.CreatedAt != nil {
+		createdAt = pr.CreatedAt.Format(time.RFC3339)
+	}
+	t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.TeamName, pr.Status, strings.Join(pr.AssignedReviewers, ","), createdAt)
+	if replacedBy != "" {
+		t.header = append(t.header, "REPLACED BY")
+		t.rows[0] = append(t.rows[0], replacedBy)
+	}
+
+	return a.out.print(out, t)
+}
+
+func (a *app) stats(ctx context.Context) error {
+	stats, err := a.prs.GetStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := statsOutput{
+		TotalPRs:      stats.PRStats.TotalPRs,
+		OpenPRs:       stats.PRStats.OpenPRs,
+		MergedPRs:     stats.PRStats.MergedPRs,
+		ReviewerStats: make([]reviewerStatsOutput, len(stats.ReviewerStats)),
+	}
+	t := table{header: []string{"REVIEWER", "USERNAME", "TEAM", "ASSIGNMENTS"}}
+	for i, rs := range stats.ReviewerStats {
+		out.ReviewerStats[i] = reviewerStatsOutput{
+			UserID:           rs.UserID,
+			Username:         rs.Username,
+			TeamName:         rs.TeamName,
+			AssignmentsCount: rs.AssignmentsCount,
+		}
+		t.add(rs.UserID, rs.Username, rs.TeamName, strconv.Itoa(rs.AssignmentsCount))
+	}
+
+	a.out.note("pull requests: %d total, %d open, %d merged\n\n", out.TotalPRs, out.OpenPRs, out.MergedPRs)
+	return a.out.print(out, t)
+}
@@ -0,0 +1,471 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aabbuukkaarr8/PRService/internal/roster"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+)
+
+type teamMemberOutput struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	IsActive bool   `json:"is_active"`
+}
+
+type teamOutput struct {
+	TeamName string             `json:"team_name"`
+	Members  []teamMemberOutput `json:"members"`
+}
+
+type teamSummaryOutput struct {
+	TeamName           string `json:"team_name"`
+	MembersCount       int    `json:"members_count"`
+	ActiveMembersCount int    `json:"active_members_count"`
+	OpenPRsCount       int    `json:"open_prs_count"`
+}
+
+type deactivateOutput struct {
+	DeactivatedUserIDs []string             `json:"deactivated_user_ids"`
+	ReassignedPRs      []reassignedPROutput `json:"reassigned_prs"`
+}
+
+type reassignedPROutput struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type teamUpdateOutput struct {
+	Team              teamOutput               `json:"team"`
+	ReassignedReviews []reassignedReviewOutput `json:"reassigned_reviews"`
+}
+
+type reassignedReviewOutput struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+type deleteTeamOutput struct {
+	TeamName          string                   `json:"team_name"`
+	DetachedUserIDs   []string                 `json:"detached_user_ids"`
+	ReassignedReviews []reassignedReviewOutput `json:"reassigned_reviews"`
+}
+
+type importUserOutput struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type membershipOutput struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type importOutput struct {
+	Applied           bool                     `json:"applied"`
+	CreatedTeams      []string                 `json:"created_teams"`
+	CreatedUsers      []importUserOutput       `json:"created_users"`
+	UpdatedUsers      []importUserOutput       `json:"updated_users"`
+	AddedMembers      []membershipOutput       `json:"added_members"`
+	RemovedMembers    []membershipOutput       `json:"removed_members"`
+	DeactivatedUsers  []importUserOutput       `json:"deactivated_users"`
+	ReassignedReviews []reassignedReviewOutput `json:"reassigned_reviews"`
+}
+
+// memberFlags собирает повторяющийся флаг -member ID:USERNAME[:inactive][:lead]
+type memberFlags []teamsrv.TeamMember
+
+func (m *memberFlags) String() string {
+	parts := make([]string, len(*m))
+	for i, member := range *m {
+		parts[i] = member.UserID + ":" + member.Username
+	}
+	return strings.Join(parts, ",")
+}
+
+func (m *memberFlags) Set(value string) error {
+	member, err := parseMember(value)
+	if err != nil {
+		return err
+	}
+	*m = append(*m, member)
+	return nil
+}
+
+func parseMember(value string) (teamsrv.TeamMember, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 4 || parts[0] == "" || parts[1] == "" {
+		return teamsrv.TeamMember{}, fmt.Errorf("invalid member %q, expected ID:USERNAME[:inactive][:lead]", value)
+	}
+
+	member := teamsrv.TeamMember{
+		UserID:   parts[0],
+		Username: parts[1],
+		Role:     teamsrv.RoleMember,
+		IsActive: true,
+	}
+	for _, modifier := range parts[2:] {
+		switch modifier {
+		case "inactive":
+			member.IsActive = false
+		case teamsrv.RoleLead:
+			member.Role = teamsrv.RoleLead
+		default:
+			return teamsrv.TeamMember{}, fmt.Errorf("invalid member flag %q, expected \"inactive\" or \"lead\"", modifier)
+		}
+	}
+
+	return member, nil
+}
+
+func (a *app) team(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "add":
+		fs := flag.NewFlagSet("team add", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		var members memberFlags
+		fs.Var(&members, "member", "team member as ID:USERNAME[:inactive][:lead], repeatable")
+		if err := parseFlags(fs, args[1:], "name"); err != nil {
+			return err
+		}
+
+		result, err := a.teams.CreateTeam(ctx, teamsrv.Team{TeamName: *name, Members: members})
+		if err != nil {
+			return err
+		}
+		return a.printTeam(result)
+	case "get":
+		fs := flag.NewFlagSet("team get", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		if err := parseFlags(fs, args[1:], "name"); err != nil {
+			return err
+		}
+
+		result, err := a.teams.GetTeam(ctx, *name)
+		if err != nil {
+			return err
+		}
+		return a.printTeam(result)
+	case "list":
+		fs := flag.NewFlagSet("team list", flag.ContinueOnError)
+		prefix := fs.String("prefix", "", "team name prefix")
+		limit := fs.Int("limit", teamsrv.DefaultListLimit, "page size")
+		offset := fs.Int("offset", 0, "number of teams to skip")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+
+		result, err := a.teams.ListTeams(ctx, teamsrv.ListTeamsParams{Prefix: *prefix, Limit: *limit, Offset: *offset})
+		if err != nil {
+			return err
+		}
+
+		out := make([]teamSummaryOutput, len(result.Teams))
+		t := table{header: []string{"TEAM", "MEMBERS", "ACTIVE", "OPEN PRS"}}
+		for i, team := range result.Teams {
+			out[i] = teamSummaryOutput{
+				TeamName:           team.TeamName,
+				MembersCount:       team.MembersCount,
+				ActiveMembersCount: team.ActiveMembersCount,
+				OpenPRsCount:       team.OpenPRsCount,
+			}
+			t.add(team.TeamName, strconv.Itoa(team.MembersCount), strconv.Itoa(team.ActiveMembersCount), strconv.Itoa(team.OpenPRsCount))
+		}
+		a.out.note("teams: %d shown, %d total\n\n", len(result.Teams), result.Total)
+		return a.out.print(out, t)
+	case "add-members":
+		fs := flag.NewFlagSet("team add-members", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		var members memberFlags
+		fs.Var(&members, "member", "team member as ID:USERNAME[:inactive][:lead], repeatable")
+		if err := parseFlags(fs, args[1:], "name", "member"); err != nil {
+			return err
+		}
+
+		result, err := a.teams.AddMembers(ctx, *name, members)
+		if err != nil {
+			return err
+		}
+		return a.printTeamUpdate(result)
+	case "remove-members":
+		fs := flag.NewFlagSet("team remove-members", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		ids := fs.String("ids", "", "comma-separated user ids")
+		if err := parseFlags(fs, args[1:], "name", "ids"); err != nil {
+			return err
+		}
+
+		result, err := a.teams.RemoveMembers(ctx, *name, strings.Split(*ids, ","))
+		if err != nil {
+			return err
+		}
+		return a.printTeamUpdate(result)
+	case "rename":
+		fs := flag.NewFlagSet("team rename", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		newName := fs.String("new-name", "", "new team name")
+		if err := parseFlags(fs, args[1:], "name", "new-name"); err != nil {
+			return err
+		}
+
+		result, err := a.teams.RenameTeam(ctx, *name, *newName)
+		if err != nil {
+			return err
+		}
+		return a.printTeam(result)
+	case "delete":
+		fs := flag.NewFlagSet("team delete", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		if err := parseFlags(fs, args[1:], "name"); err != nil {
+			return err
+		}
+
+		result, err := a.teams.DeleteTeam(ctx, *name)
+		if err != nil {
+			return err
+		}
+
+		out := deleteTeamOutput{
+			TeamName:          result.TeamName,
+			DetachedUserIDs:   result.DetachedUserIDs,
+			ReassignedReviews: make([]reassignedReviewOutput, len(result.ReassignedReviews)),
+		}
+		for i, r := range result.ReassignedReviews {
+			out.ReassignedReviews[i] = reassignedReviewOutput{
+				PullRequestID: r.PullRequestID,
+				OldReviewerID: r.OldReviewerID,
+				NewReviewerID: r.NewReviewerID,
+			}
+		}
+		t := table{header: []string{"TEAM", "DETACHED USER ID"}}
+		for _, userID := range result.DetachedUserIDs {
+			t.add(result.TeamName, userID)
+		}
+		return a.out.print(out, t)
+	case "import":
+		fs := flag.NewFlagSet("team import", flag.ContinueOnError)
+		file := fs.String("f", "", "roster file (.yaml, .yml, .json or .csv)")
+		dryRun := fs.Bool("dry-run", false, "only show the diff, do not apply it")
+		if err := parseFlags(fs, args[1:], "f"); err != nil {
+			return err
+		}
+
+		parsed, err := readRoster(*file)
+		if err != nil {
+			return err
+		}
+
+		result, err := a.teams.ImportTeams(ctx, parsed.ToService(), *dryRun)
+		if err != nil {
+			return err
+		}
+		return a.printImport(result)
+	case "deactivate":
+		fs := flag.NewFlagSet("team deactivate", flag.ContinueOnError)
+		name := fs.String("name", "", "team name")
+		if err := parseFlags(fs, args[1:], "name"); err != nil {
+			return err
+		}
+
+		result, err := a.prs.BulkDeactivateTeamUsers(ctx, *name)
+		if err != nil {
+			return err
+		}
+
+		out := deactivateOutput{
+			DeactivatedUserIDs: result.DeactivatedUserIDs,
+			ReassignedPRs:      make([]reassignedPROutput, len(result.ReassignedPRs)),
+		}
+		t := table{header: []string{"PULL REQUEST", "OLD REVIEWER", "NEW REVIEWER"}}
+		for i, pr := range result.ReassignedPRs {
+			out.ReassignedPRs[i] = reassignedPROutput{
+				PullRequestID: pr.PullRequestID,
+				OldReviewerID: pr.OldReviewerID,
+				NewReviewerID: pr.NewReviewerID,
+			}
+			t.add(pr.PullRequestID, pr.OldReviewerID, pr.NewReviewerID)
+		}
+		a.out.note("deactivated: %s\n\n", strings.Join(result.DeactivatedUserIDs, ", "))
+		return a.out.print(out, t)
+	default:
+		return errUsage
+	}
+}
+
+func (a *app) printTeamUpdate(result teamsrv.TeamUpdateResult) error {
+	if a.out.json {
+		out := teamUpdateOutput{
+			Team:              newTeamOutput(result.Team),
+			ReassignedReviews: make([]reassignedReviewOutput, len(result.ReassignedReviews)),
+		}
+		for i, r := range result.ReassignedReviews {
+			out.ReassignedReviews[i] = reassignedReviewOutput{
+				PullRequestID: r.PullRequestID,
+				OldReviewerID: r.OldReviewerID,
+				NewReviewerID: r.NewReviewerID,
+			}
+		}
+		return a.out.print(out, table{})
+	}
+
+	if err := a.printTeam(result.Team); err != nil {
+		return err
+	}
+	if len(result.ReassignedReviews) == 0 {
+		return nil
+	}
+
+	a.out.note("\n")
+	t := table{header: []string{"PULL REQUEST", "OLD REVIEWER", "NEW REVIEWER"}}
+	for _, r := range result.ReassignedReviews {
+		newReviewer := r.NewReviewerID
+		if newReviewer == "" {
+			newReviewer = "-"
+		}
+		t.add(r.PullRequestID, r.OldReviewerID, newReviewer)
+	}
+	return a.out.print(nil, t)
+}
+
+func newTeamOutput(team teamsrv.Team) teamOutput {
+	out := teamOutput{
+		TeamName: team.TeamName,
+		Members:  make([]teamMemberOutput, len(team.Members)),
+	}
+	for i, m := range team.Members {
+		out.Members[i] = teamMemberOutput{
+			UserID:   m.UserID,
+			Username: m.Username,
+			Role:     m.Role,
+			IsActive: m.IsActive,
+		}
+	}
+	return out
+}
+
+func (a *app) printTeam(team teamsrv.Team) error {
+	t := table{header: []string{"TEAM", "USER ID", "USERNAME", "ROLE", "ACTIVE"}}
+	for _, m := range team.Members {
+		t.add(team.TeamName, m.UserID, m.Username, m.Role, yesNo(m.IsActive))
+	}
+	return a.out.print(newTeamOutput(team), t)
+}
+
+func readRoster(path string) (roster.Roster, error) {
+	format, err := roster.FormatFromFileName(path)
+	if err != nil {
+		return roster.Roster{}, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return roster.Roster{}, err
+	}
+	defer f.Close()
+
+	return roster.Parse(f, format)
+}
+
+func (a *app) printImport(result teamsrv.ImportResult) error {
+	toOutput := func(users []teamsrv.ImportUser) []importUserOutput {
+		out := make([]importUserOutput, len(users))
+		for i, u := range users {
+			out[i] = importUserOutput{
+				UserID:   u.UserID,
+				Username: u.Username,
+				IsActive: u.IsActive,
+			}
+		}
+		return out
+	}
+	toMemberships := func(memberships []teamsrv.Membership) []membershipOutput {
+		out := make([]membershipOutput, len(memberships))
+		for i, m := range memberships {
+			out[i] = membershipOutput{
+				TeamName: m.TeamName,
+				UserID:   m.UserID,
+				Username: m.Username,
+			}
+		}
+		return out
+	}
+
+	diff := result.Diff
+	out := importOutput{
+		Applied:           result.Applied,
+		CreatedTeams:      append([]string{}, diff.CreatedTeams...),
+		CreatedUsers:      toOutput(diff.CreatedUsers),
+		UpdatedUsers:      toOutput(diff.UpdatedUsers),
+		AddedMembers:      toMemberships(diff.AddedMembers),
+		RemovedMembers:    toMemberships(diff.RemovedMembers),
+		DeactivatedUsers:  toOutput(diff.DeactivatedUsers),
+		ReassignedReviews: make([]reassignedReviewOutput, len(diff.ReassignedReviews)),
+	}
+	for i, r := range diff.ReassignedReviews {
+		out.ReassignedReviews[i] = reassignedReviewOutput{
+			PullRequestID: r.PullRequestID,
+			OldReviewerID: r.OldReviewerID,
+			NewReviewerID: r.NewReviewerID,
+		}
+	}
+
+	t := table{header: []string{"CHANGE", "TEAM", "USER ID", "USERNAME", "ACTIVE"}}
+	for _, teamName := range diff.CreatedTeams {
+		t.add("create team", teamName, "", "", "")
+	}
+	for _, u := range diff.CreatedUsers {
+		t.add("create user", "", u.UserID, u.Username, yesNo(u.IsActive))
+	}
+	for _, u := range diff.UpdatedUsers {
+		t.add("update user", "", u.UserID, u.Username, yesNo(u.IsActive))
+	}
+	for _, m := range diff.AddedMembers {
+		t.add("add member", m.TeamName, m.UserID, m.Username, "")
+	}
+	for _, m := range diff.RemovedMembers {
+		t.add("remove member", m.TeamName, m.UserID, m.Username, "")
+	}
+	for _, u := range diff.DeactivatedUsers {
+		t.add("deactivate user", "", u.UserID, u.Username, yesNo(u.IsActive))
+	}
+
+	switch {
+	case len(t.rows) == 0:
+		a.out.note("nothing to change\n\n")
+	case result.Applied:
+		a.out.note("applied:\n\n")
+	default:
+		a.out.note("dry run, nothing applied:\n\n")
+	}
+	if err := a.out.print(out, t); err != nil {
+		return err
+	}
+	if a.out.json || len(diff.ReassignedReviews) == 0 {
+		return nil
+	}
+
+	a.out.note("\n")
+	reviews := table{header: []string{"PULL REQUEST", "OLD REVIEWER", "NEW REVIEWER"}}
+	for _, r := range diff.ReassignedReviews {
+		newReviewer := r.NewReviewerID
+		if newReviewer == "" {
+			newReviewer = "-"
+		}
+		reviews.add(r.PullRequestID, r.OldReviewerID, newReviewer)
+	}
+	return a.out.print(nil, reviews)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMember(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    teamsrv.TeamMember
+		expectError bool
+	}{
+		{
+			name:     "active member",
+			value:    "u1:Alice",
+			expected: teamsrv.TeamMember{UserID: "u1", Username: "Alice", Role: teamsrv.RoleMember, IsActive: true},
+		},
+		{
+			name:     "inactive member",
+			value:    "u2:Bob:inactive",
+			expected: teamsrv.TeamMember{UserID: "u2", Username: "Bob", Role: teamsrv.RoleMember, IsActive: false},
+		},
+		{
+			name:     "inactive lead",
+			value:    "u3:Carol:inactive:lead",
+			expected: teamsrv.TeamMember{UserID: "u3", Username: "Carol", Role: teamsrv.RoleLead, IsActive: false},
+		},
+		{
+			name:        "missing username",
+			value:       "u1",
+			expectError: true,
+		},
+		{
+			name:        "empty id",
+			value:       ":Alice",
+			expectError: true,
+		},
+		{
+			name:        "unknown modifier",
+			value:       "u1:Alice:admin",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member, err := parseMember(tt.value)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, member)
+		})
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	data := teamOutput{
+		TeamName: "backend",
+		Members:  []teamMemberOutput{{UserID: "u1", Username: "Alice", Role: "member", IsActive: true}},
+	}
+	tbl := table{header: []string{"TEAM", "USER ID"}}
+	tbl.add("backend", "u1")
+
+	var buf bytes.Buffer
+	p, err := newPrinter(&buf, "table")
+	assert.NoError(t, err)
+	assert.NoError(t, p.print(data, tbl))
+	assert.Equal(t, "TEAM     USER ID\nbackend  u1\n", buf.String())
+
+	buf.Reset()
+	p, err = newPrinter(&buf, "json")
+	assert.NoError(t, err)
+	p.note("ignored in json mode\n")
+	assert.NoError(t, p.print(data, tbl))
+	assert.JSONEq(t, `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","role":"member","is_active":true}]}`, buf.String())
+
+	_, err = newPrinter(&buf, "yaml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+)
+
+type userOutput struct {
+	UserID    string   `json:"user_id"`
+	Username  string   `json:"username"`
+	TeamNames []string `json:"team_names"`
+	Expertise []string `json:"expertise"`
+	IsActive  bool     `json:"is_active"`
+}
+
+type pullRequestShortOutput struct {
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	AuthorUsername  string   `json:"author_username"`
+	Status          string   `json:"status"`
+	CoReviewers     []string `json:"co_reviewers"`
+	AgeSeconds      int64    `json:"age_seconds"`
+}
+
+type reviewsOutput struct {
+	UserID       string                   `json:"user_id"`
+	PullRequests []pullRequestShortOutput `json:"pull_requests"`
+	Total        int                      `json:"total"`
+}
+
+func (a *app) user(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "get":
+		fs := flag.NewFlagSet("user get", flag.ContinueOnError)
+		id := fs.String("id", "", "user id")
+		if err := parseFlags(fs, args[1:], "id"); err != nil {
+			return err
+		}
+
+		result, err := a.users.GetUser(ctx, *id)
+		if err != nil {
+			return err
+		}
+		return a.printUser(result)
+	case "list":
+		fs := flag.NewFlagSet("user list", flag.ContinueOnError)
+		teamName := fs.String("team", "", "only members of the team")
+		prefix := fs.String("prefix", "", "username prefix")
+		active := fs.String("active", "", "only active (true) or inactive (false) users")
+		limit := fs.Int("limit", usersrv.DefaultListLimit, "page size")
+		offset := fs.Int("offset", 0, "number of users to skip")
+		if err := parseFlags(fs, args[1:]); err != nil {
+			return err
+		}
+
+		params := usersrv.ListUsersParams{
+			TeamName:       *teamName,
+			UsernamePrefix: *prefix,
+			Limit:          *limit,
+			Offset:         *offset,
+		}
+		if *active != "" {
+			isActive, err := strconv.ParseBool(*active)
+			if err != nil {
+				return fmt.Errorf("flag -active must be true or false")
+			}
+			params.IsActive = &isActive
+		}
+
+		result, err := a.users.ListUsers(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		out := make([]userOutput, len(result.Users))
+		t := table{header: []string{"USER ID", "USERNAME", "TEAMS", "ACTIVE"}}
+		for i, u := range result.Users {
+			out[i] = toUserOutput(u)
+			t.add(u.UserID, u.Username, strings.Join(u.TeamNames, ","), yesNo(u.IsActive))
+		}
+		a.out.note("users: %d shown, %d total\n\n", len(result.Users), result.Total)
+		return a.out.print(out, t)
+	case "update":
+		fs := flag.NewFlagSet("user update", flag.ContinueOnError)
+		id := fs.String("id", "", "user id")
+		username := fs.String("username", "", "new username")
+		if err := parseFlags(fs, args[1:], "id", "username"); err != nil {
+			return err
+		}
+
+		result, err := a.users.UpdateUser(ctx, *id, *username)
+		if err != nil {
+			return err
+		}
+		return a.printUser(result)
+	case "set-expertise":
+		fs := flag.NewFlagSet("user set-expertise", flag.ContinueOnError)
+		id := fs.String("id", "", "user id")
+		tags := fs.String("tags", "", "comma-separated expertise tags (empty clears)")
+		if err := parseFlags(fs, args[1:], "id"); err != nil {
+			return err
+		}
+
+		result, err := a.users.SetExpertise(ctx, *id, splitList(*tags))
+		if err != nil {
+			return err
+		}
+		return a.printUser(result)
+	case "set-active":
+		fs := flag.NewFlagSet("user set-active", flag.ContinueOnError)
+		id := fs.String("id", "", "user id")
+		active := fs.Bool("active", true, "active state")
+		if err := parseFlags(fs, args[1:], "id"); err != nil {
+			return err
+		}
+
+		result, err := a.users.SetIsActive(ctx, *id, *active)
+		if err != nil {
+			return err
+		}
+		return a.printUser(result)
+	case "reviews":
+		fs := flag.NewFlagSet("user reviews", flag.ContinueOnError)
+		id := fs.String("id", "", "user id")
+		status := fs.String("status", "", "comma-separated statuses: OPEN, MERGED (default OPEN)")
+		order := fs.String("order", usersrv.OrderAsc, "asc (oldest first) or desc")
+		limit := fs.Int("limit", usersrv.DefaultListLimit, "page size")
+		offset := fs.Int("offset", 0, "number of pull requests to skip")
+		if err := parseFlags(fs, args[1:], "id"); err != nil {
+			return err
+		}
+
+		result, err := a.users.GetReview(ctx, usersrv.ReviewParams{
+			UserID:   *id,
+			Statuses: splitList(strings.ToUpper(*status)),
+			Order:    *order,
+			Limit:    *limit,
+			Offset:   *offset,
+		})
+		if err != nil {
+			return err
+		}
+
+		out := reviewsOutput{
+			UserID:       *id,
+			PullRequests: make([]pullRequestShortOutput, len(result.PullRequests)),
+			Total:        result.Total,
+		}
+		t := table{header: []string{"PULL REQUEST", "NAME", "AUTHOR", "STATUS", "CO-REVIEWERS", "AGE"}}
+		for i, pr := range result.PullRequests {
+			out.PullRequests[i] = pullRequestShortOutput{
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				AuthorUsername:  pr.AuthorUsername,
+				Status:          pr.Status,
+				CoReviewers:     pr.CoReviewers,
+				AgeSeconds:      int64(pr.Age.Seconds()),
+			}
+			t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, strings.Join(pr.CoReviewers, ","), pr.Age.Truncate(time.Minute).String())
+		}
+		a.out.note("pull requests: %d shown, %d total\n\n", len(result.PullRequests), result.Total)
+		return a.out.print(out, t)
+	default:
+		return errUsage
+	}
+}
+
+func (a *app) printUser(u usersrv.User) error {
+	t := table{header: []string{"USER ID", "USERNAME", "TEAMS", "EXPERTISE", "ACTIVE"}}
+	t.add(u.UserID, u.Username, strings.Join(u.TeamNames, ","), strings.Join(u.Expertise, ","), yesNo(u.IsActive))
+	return a.out.print(toUserOutput(u), t)
+}
+
+func toUserOutput(u usersrv.User) userOutput {
+	teamNames := u.TeamNames
+	if teamNames == nil {
+		teamNames = []string{}
+	}
+	expertise := u.Expertise
+	if expertise == nil {
+		expertise = []string{}
+	}
+	return userOutput{
+		UserID:    u.UserID,
+		Username:  u.Username,
+		TeamNames: teamNames,
+		Expertise: expertise,
+		IsActive:  u.IsActive,
+	}
+}
@@ -0,0 +1,19 @@
+// Package db содержит SQL миграции схемы, встроенные в бинарник.
+package db
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Migrations возвращает файлы миграций вида <version>_<name>.up.sql / <version>_<name>.down.sql
+func Migrations() fs.FS {
+	sub, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -1,4 +1,3 @@
-CREATE TABLE teams (
+CREATE TABLE IF NOT EXISTS teams (
     team_name TEXT PRIMARY KEY
 );
-
@@ -1,10 +1,9 @@
-CREATE TABLE users (
+CREATE TABLE IF NOT EXISTS users (
     user_id TEXT PRIMARY KEY,
     username TEXT NOT NULL,
     team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
     is_active BOOLEAN NOT NULL DEFAULT TRUE
 );
 
-CREATE INDEX idx_users_team_name ON users(team_name);
-CREATE INDEX idx_users_is_active ON users(is_active);
-
+CREATE INDEX IF NOT EXISTS idx_users_team_name ON users(team_name);
+CREATE INDEX IF NOT EXISTS idx_users_is_active ON users(is_active);
@@ -1,4 +1,4 @@
-CREATE TABLE pullrequests (
+CREATE TABLE IF NOT EXISTS pullrequests (
     pull_request_id TEXT PRIMARY KEY,
     pull_request_name TEXT NOT NULL,
     author_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
@@ -8,7 +8,6 @@ CREATE TABLE pullrequests (
     merged_at TIMESTAMP
 );
 
-CREATE INDEX idx_pullrequests_author_id ON pullrequests(author_id);
-CREATE INDEX idx_pullrequests_status ON pullrequests(status);
-CREATE INDEX idx_pullrequests_assigned_reviewers ON pullrequests USING GIN(assigned_reviewers);
-
+CREATE INDEX IF NOT EXISTS idx_pullrequests_author_id ON pullrequests(author_id);
+CREATE INDEX IF NOT EXISTS idx_pullrequests_status ON pullrequests(status);
+CREATE INDEX IF NOT EXISTS idx_pullrequests_assigned_reviewers ON pullrequests USING GIN(assigned_reviewers);
@@ -0,0 +1,6 @@
+-- пользователь может остаться без команды после исключения из нее или удаления команды,
+-- переименование команды каскадно обновляет пользователей
+ALTER TABLE users ALTER COLUMN team_name DROP NOT NULL;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE users ADD CONSTRAINT users_team_name_fkey
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,16 @@
+-- при откате пользователь остается в одной команде (первой по имени)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS team_name TEXT
+    REFERENCES teams(team_name) ON DELETE SET NULL ON UPDATE CASCADE;
+
+UPDATE users u SET team_name = tm.team_name
+FROM (
+    SELECT user_id, MIN(team_name) AS team_name FROM team_members GROUP BY user_id
+) tm
+WHERE u.user_id = tm.user_id;
+
+CREATE INDEX IF NOT EXISTS idx_users_team_name ON users(team_name);
+
+DROP INDEX IF EXISTS idx_pullrequests_team_name;
+ALTER TABLE pullrequests DROP COLUMN IF EXISTS team_name;
+
+DROP TABLE IF EXISTS team_members;
@@ -0,0 +1,26 @@
+-- участие пользователей в командах: пользователь может состоять в нескольких командах
+CREATE TABLE IF NOT EXISTS team_members (
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    role TEXT NOT NULL DEFAULT 'member' CHECK (role IN ('member', 'lead')),
+    PRIMARY KEY (team_name, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_members_user_id ON team_members(user_id);
+
+INSERT INTO team_members (team_name, user_id)
+SELECT team_name, user_id FROM users WHERE team_name IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- PR привязывается к команде, из которой выбираются ревьюверы
+ALTER TABLE pullrequests ADD COLUMN IF NOT EXISTS team_name TEXT
+    REFERENCES teams(team_name) ON DELETE SET NULL ON UPDATE CASCADE;
+
+UPDATE pullrequests pr SET team_name = u.team_name
+FROM users u
+WHERE pr.author_id = u.user_id AND pr.team_name IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pullrequests_team_name ON pullrequests(team_name);
+
+DROP INDEX IF EXISTS idx_users_team_name;
+ALTER TABLE users DROP COLUMN IF EXISTS team_name;
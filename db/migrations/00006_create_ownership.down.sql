@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS ownership_rule_teams;
+DROP TABLE IF EXISTS ownership_rule_users;
+DROP TABLE IF EXISTS ownership_rules;
+
+ALTER TABLE pullrequests DROP COLUMN IF EXISTS labels;
+ALTER TABLE pullrequests DROP COLUMN IF EXISTS files;
+
+DROP TABLE IF EXISTS user_expertise;
@@ -0,0 +1,30 @@
+-- экспертиза пользователей: ревьюверы с тегом, совпадающим с меткой PR, выбираются в первую очередь
+CREATE TABLE IF NOT EXISTS user_expertise (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (user_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_expertise_tag ON user_expertise(tag);
+
+-- измененные файлы и метки PR, по ним подбираются владельцы кода и эксперты
+ALTER TABLE pullrequests ADD COLUMN IF NOT EXISTS files TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE pullrequests ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}';
+
+-- правила владения кодом в стиле CODEOWNERS: для файла действует последнее совпавшее правило (по rule_id)
+CREATE TABLE IF NOT EXISTS ownership_rules (
+    rule_id BIGSERIAL PRIMARY KEY,
+    pattern TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS ownership_rule_users (
+    rule_id BIGINT NOT NULL REFERENCES ownership_rules(rule_id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (rule_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS ownership_rule_teams (
+    rule_id BIGINT NOT NULL REFERENCES ownership_rules(rule_id) ON DELETE CASCADE,
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    PRIMARY KEY (rule_id, team_name)
+);
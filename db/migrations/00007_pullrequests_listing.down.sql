@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_pullrequests_created_at_id;
+
+ALTER TABLE pullrequests ALTER COLUMN created_at DROP NOT NULL;
+ALTER TABLE pullrequests ALTER COLUMN created_at DROP DEFAULT;
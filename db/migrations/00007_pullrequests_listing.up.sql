@@ -0,0 +1,6 @@
+-- created_at участвует в сортировке и курсоре списка PR, поэтому всегда заполнен
+UPDATE pullrequests SET created_at = NOW() WHERE created_at IS NULL;
+ALTER TABLE pullrequests ALTER COLUMN created_at SET DEFAULT NOW();
+ALTER TABLE pullrequests ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pullrequests_created_at_id ON pullrequests(created_at, pull_request_id);
@@ -0,0 +1 @@
+ALTER TABLE pullrequests DROP COLUMN IF EXISTS version;
@@ -0,0 +1,2 @@
+-- версия PR увеличивается при каждом изменении; обновления сравнивают ее, чтобы не затереть параллельное изменение
+ALTER TABLE pullrequests ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
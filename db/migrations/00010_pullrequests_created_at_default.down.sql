@@ -0,0 +1 @@
+ALTER TABLE pullrequests ALTER COLUMN created_at SET DEFAULT NOW();
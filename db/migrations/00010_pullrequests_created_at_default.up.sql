@@ -0,0 +1,3 @@
+-- created_at хранится без часового пояса в локальном времени сервиса и всегда передается сервисом;
+-- NOW() по умолчанию записал бы время в поясе сессии БД
+ALTER TABLE pullrequests ALTER COLUMN created_at DROP DEFAULT;
@@ -0,0 +1,150 @@
+// Package apitest помогает тестировать хендлеры через сгенерированный роутер
+package apitest
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterHandlers регистрирует маршруты так же, как сервер: параметры запроса разбирает сгенерированный код.
+// handler - Handler одного пакета; операции, которых у него нет, в тестах не вызываются
+func RegisterHandlers(router gin.IRouter, handler any) {
+	models.RegisterHandlersWithOptions(router, server{handler: handler}, models.GinServerOptions{
+		ErrorHandler: api.SendParamError,
+	})
+}
+
+// server передает вызовы ServerInterface одноименным методам handler
+type server struct {
+	handler any
+}
+
+var _ models.ServerInterface = server{}
+
+func (s server) call(name string, args ...any) {
+	method := reflect.ValueOf(s.handler).MethodByName(name)
+	if !method.IsValid() {
+		panic(fmt.Sprintf("apitest: %T has no method %s", s.handler, name))
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		in[i] = reflect.ValueOf(arg)
+	}
+	method.Call(in)
+}
+
+func (s server) ImportRules(c *gin.Context) {
+	s.call("ImportRules", c)
+}
+
+func (s server) ResolveOwners(c *gin.Context) {
+	s.call("ResolveOwners", c)
+}
+
+func (s server) DeleteRule(c *gin.Context, params models.DeleteRuleParams) {
+	s.call("DeleteRule", c, params)
+}
+
+func (s server) ListRules(c *gin.Context) {
+	s.call("ListRules", c)
+}
+
+func (s server) CreateRule(c *gin.Context) {
+	s.call("CreateRule", c)
+}
+
+func (s server) AddReviewer(c *gin.Context, params models.AddReviewerParams) {
+	s.call("AddReviewer", c, params)
+}
+
+func (s server) CreatePullRequest(c *gin.Context) {
+	s.call("CreatePullRequest", c)
+}
+
+func (s server) GetPullRequest(c *gin.Context, params models.GetPullRequestParams) {
+	s.call("GetPullRequest", c, params)
+}
+
+func (s server) ListPullRequests(c *gin.Context, params models.ListPullRequestsParams) {
+	s.call("ListPullRequests", c, params)
+}
+
+func (s server) MergePullRequest(c *gin.Context, params models.MergePullRequestParams) {
+	s.call("MergePullRequest", c, params)
+}
+
+func (s server) ReassignReviewer(c *gin.Context, params models.ReassignReviewerParams) {
+	s.call("ReassignReviewer", c, params)
+}
+
+func (s server) RemoveReviewer(c *gin.Context, params models.RemoveReviewerParams) {
+	s.call("RemoveReviewer", c, params)
+}
+
+func (s server) GetStats(c *gin.Context) {
+	s.call("GetStats", c)
+}
+
+func (s server) CreateTeam(c *gin.Context) {
+	s.call("CreateTeam", c)
+}
+
+func (s server) AddMembers(c *gin.Context) {
+	s.call("AddMembers", c)
+}
+
+func (s server) BulkDeactivateTeamUsers(c *gin.Context) {
+	s.call("BulkDeactivateTeamUsers", c)
+}
+
+func (s server) DeleteTeam(c *gin.Context, params models.DeleteTeamParams) {
+	s.call("DeleteTeam", c, params)
+}
+
+func (s server) GetTeam(c *gin.Context, params models.GetTeamParams) {
+	s.call("GetTeam", c, params)
+}
+
+func (s server) ImportTeams(c *gin.Context, params models.ImportTeamsParams) {
+	s.call("ImportTeams", c, params)
+}
+
+func (s server) ListTeams(c *gin.Context, params models.ListTeamsParams) {
+	s.call("ListTeams", c, params)
+}
+
+func (s server) RemoveMembers(c *gin.Context) {
+	s.call("RemoveMembers", c)
+}
+
+func (s server) RenameTeam(c *gin.Context) {
+	s.call("RenameTeam", c)
+}
+
+func (s server) GetUser(c *gin.Context, params models.GetUserParams) {
+	s.call("GetUser", c, params)
+}
+
+func (s server) GetReview(c *gin.Context, params models.GetReviewParams) {
+	s.call("GetReview", c, params)
+}
+
+func (s server) ListUsers(c *gin.Context, params models.ListUsersParams) {
+	s.call("ListUsers", c, params)
+}
+
+func (s server) SetExpertise(c *gin.Context) {
+	s.call("SetExpertise", c)
+}
+
+func (s server) SetIsActive(c *gin.Context) {
+	s.call("SetIsActive", c)
+}
+
+func (s server) UpdateUser(c *gin.Context) {
+	s.call("UpdateUser", c)
+}
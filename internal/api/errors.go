@@ -0,0 +1,69 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const internalErrorMessage = "internal server error"
+
+var kindStatus = map[apperr.Kind]int{
+	apperr.KindInvalid:            http.StatusBadRequest,
+	apperr.KindNotFound:           http.StatusNotFound,
+	apperr.KindConflict:           http.StatusConflict,
+	apperr.KindPreconditionFailed: http.StatusPreconditionFailed,
+}
+
+// ErrorMiddleware отвечает на ошибку, переданную хендлером через c.Error, если ответ еще не записан.
+// Ошибки предметной области отдаются клиенту со своим кодом и текстом, нарушение внешнего ключа - как NOT_FOUND.
+// Дубликаты ключей сервисы переводят в *_EXISTS сами; непереведенный дубликат, как и остальные ошибки,
+// логируется и возвращается как 500 без подробностей.
+// Подключается последним перед хендлерами, чтобы ответ видели внешние middleware
+func ErrorMiddleware(logger *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		if appErr, ok := apperr.As(err); ok {
+			if status, ok := kindStatus[appErr.Kind]; ok {
+				SendError(c, status, Error{
+					Code:    models.ErrorResponseErrorCode(appErr.Code),
+					Message: err.Error(),
+				})
+				return
+			}
+		}
+
+		switch {
+		case errors.Is(err, store.ErrForeignKeyViolated):
+			SendError(c, http.StatusNotFound, Error{
+				Code:    models.NOTFOUND,
+				Message: "referenced object not found",
+			})
+		default:
+			logging.FromContextOr(c.Request.Context(), logger).WithError(err).
+				WithField("path", c.Request.URL.Path).Error("Request failed")
+			SendInternalError(c)
+		}
+	}
+}
+
+// SendInternalError отвечает 500 с общим сообщением и request_id; подробности ошибки пишутся только в лог
+func SendInternalError(c *gin.Context) {
+	SendError(c, http.StatusInternalServerError, Error{
+		Code:      "INTERNAL_ERROR",
+		Message:   internalErrorMessage,
+		RequestID: logging.RequestID(c.Request.Context()),
+	})
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	errPRMerged := apperr.New(apperr.KindConflict, "PR_MERGED", "cannot change reviewers on merged PR")
+	errNotFound := apperr.New(apperr.KindNotFound, "NOT_FOUND", "not found")
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedCode    string
+		expectedMessage string
+		expectLog       bool
+	}{
+		{
+			name:            "domain error",
+			err:             errPRMerged,
+			expectedStatus:  http.StatusConflict,
+			expectedCode:    "PR_MERGED",
+			expectedMessage: "cannot change reviewers on merged PR",
+		},
+		{
+			name:            "wrapped domain error keeps details",
+			err:             fmt.Errorf("%w: PR pr-1", errNotFound),
+			expectedStatus:  http.StatusNotFound,
+			expectedCode:    "NOT_FOUND",
+			expectedMessage: "not found: PR pr-1",
+		},
+		{
+			name:            "foreign key violation",
+			err:             fmt.Errorf("%w: insert or update violates foreign key constraint", store.ErrForeignKeyViolated),
+			expectedStatus:  http.StatusNotFound,
+			expectedCode:    "NOT_FOUND",
+			expectedMessage: "referenced object not found",
+		},
+		{
+			name:            "untranslated duplicate key is hidden",
+			err:             fmt.Errorf("%w: duplicate key value violates unique constraint", store.ErrDuplicateKey),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    "INTERNAL_ERROR",
+			expectedMessage: "internal server error",
+			expectLog:       true,
+		},
+		{
+			name:            "internal error is hidden",
+			err:             errors.New(`pq: relation "pullrequests" does not exist`),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    "INTERNAL_ERROR",
+			expectedMessage: "internal server error",
+			expectLog:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, hook := test.NewNullLogger()
+
+			router := gin.New()
+			router.Use(logging.Middleware(logger), ErrorMiddleware(logger))
+			router.GET("/fail", func(c *gin.Context) {
+				_ = c.Error(tt.err)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/fail", nil)
+			req.Header.Set(logging.HeaderRequestID, "req-1")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response struct {
+				Error Error `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, string(response.Error.Code))
+			assert.Equal(t, tt.expectedMessage, response.Error.Message)
+
+			var logged bool
+			for _, entry := range hook.AllEntries() {
+				if entry.Message == "Request failed" {
+					logged = true
+					assert.Equal(t, logrus.ErrorLevel, entry.Level)
+					assert.Equal(t, tt.err, entry.Data[logrus.ErrorKey])
+					assert.Equal(t, "req-1", entry.Data["request_id"])
+				}
+			}
+			assert.Equal(t, tt.expectLog, logged)
+
+			if tt.expectLog {
+				assert.Equal(t, "req-1", response.Error.RequestID)
+				assert.NotContains(t, w.Body.String(), "pq:")
+			} else {
+				assert.Empty(t, response.Error.RequestID)
+			}
+		})
+	}
+}
+
+func TestErrorMiddleware_ResponseAlreadyWritten(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	logger, _ := test.NewNullLogger()
+	router := gin.New()
+	router.Use(ErrorMiddleware(logger))
+	router.GET("/partial", func(c *gin.Context) {
+		c.String(http.StatusAccepted, "accepted")
+		_ = c.Error(errors.New("late error"))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/partial", nil))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, "accepted", w.Body.String())
+}
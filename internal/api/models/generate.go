@@ -0,0 +1,7 @@
+package models
+
+// Типы и интерфейс gin-сервера генерируются из openApi/openapi 2.yml; после изменения спецификации
+// выполнить make generate
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config models.cfg.yaml "../../../openApi/openapi 2.yml"
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config server.cfg.yaml "../../../openApi/openapi 2.yml"
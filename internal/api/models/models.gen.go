@@ -1,25 +1,31 @@
 // Package models provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package models
 
 import (
 	"time"
 )
 
-const (
-	AdminTokenScopes = "AdminToken.Scopes"
-	UserTokenScopes  = "UserToken.Scopes"
-)
-
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED          ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	CONCURRENTUPDATE         ErrorResponseErrorCode = "CONCURRENT_UPDATE"
+	CONFLICT                 ErrorResponseErrorCode = "CONFLICT"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYMISMATCH   ErrorResponseErrorCode = "IDEMPOTENCY_KEY_MISMATCH"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	INVALIDREQUEST           ErrorResponseErrorCode = "INVALID_REQUEST"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	TOOMANYREVIEWERS         ErrorResponseErrorCode = "TOO_MANY_REVIEWERS"
+	USEREXISTS               ErrorResponseErrorCode = "USER_EXISTS"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
 )
 
 // Defines values for PullRequestStatus.
@@ -28,41 +34,175 @@ const (
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
+// Defines values for PullRequestDetailsStatus.
+const (
+	PullRequestDetailsStatusMERGED PullRequestDetailsStatus = "MERGED"
+	PullRequestDetailsStatusOPEN   PullRequestDetailsStatus = "OPEN"
+)
+
 // Defines values for PullRequestShortStatus.
 const (
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for TeamMemberRole.
+const (
+	Lead   TeamMemberRole = "lead"
+	Member TeamMemberRole = "member"
+)
+
+// Defines values for ListPullRequestsParamsStatus.
+const (
+	ListPullRequestsParamsStatusMERGED ListPullRequestsParamsStatus = "MERGED"
+	ListPullRequestsParamsStatusOPEN   ListPullRequestsParamsStatus = "OPEN"
+)
+
+// Defines values for ListPullRequestsParamsSort.
+const (
+	CreatedAt     ListPullRequestsParamsSort = "created_at"
+	PullRequestId ListPullRequestsParamsSort = "pull_request_id"
+)
+
+// Defines values for ListPullRequestsParamsOrder.
+const (
+	ListPullRequestsParamsOrderAsc  ListPullRequestsParamsOrder = "asc"
+	ListPullRequestsParamsOrderDesc ListPullRequestsParamsOrder = "desc"
+)
+
+// Defines values for ImportTeamsParamsFormat.
+const (
+	Csv  ImportTeamsParamsFormat = "csv"
+	Yaml ImportTeamsParamsFormat = "yaml"
+)
+
+// Defines values for GetReviewParamsOrder.
+const (
+	GetReviewParamsOrderAsc  GetReviewParamsOrder = "asc"
+	GetReviewParamsOrderDesc GetReviewParamsOrder = "desc"
+)
+
+// ChangeReviewerRequest defines model for ChangeReviewerRequest.
+type ChangeReviewerRequest struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Ошибки проверки отдельных полей запроса (для INVALID_REQUEST)
+		Fields *[]struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса (для INTERNAL_ERROR)
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ImportDiff defines model for ImportDiff.
+type ImportDiff struct {
+	AddedMembers     []Membership `json:"added_members"`
+	CreatedTeams     []string     `json:"created_teams"`
+	CreatedUsers     []ImportUser `json:"created_users"`
+	DeactivatedUsers []ImportUser `json:"deactivated_users"`
+
+	// ReassignedReviews Замены исключенных и ставших неактивными ревьюверов в открытых PR
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+	RemovedMembers    []Membership       `json:"removed_members"`
+	UpdatedUsers      []ImportUser       `json:"updated_users"`
+}
+
+// ImportUser defines model for ImportUser.
+type ImportUser struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// Membership defines model for Membership.
+type Membership struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// PathOwners defines model for PathOwners.
+type PathOwners struct {
+	Path string `json:"path"`
+
+	// Pattern Сработавшее правило; пусто, если владельцев нет
+	Pattern   *string  `json:"pattern,omitempty"`
+	TeamNames []string `json:"team_names"`
+	UserIds   []string `json:"user_ids"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
 	AssignedReviewers []string          `json:"assigned_reviewers"`
 	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
+	CreatedAt         *time.Time        `json:"created_at"`
+	Files             *[]string         `json:"files,omitempty"`
+	Labels            *[]string         `json:"labels,omitempty"`
+	MergedAt          *time.Time        `json:"merged_at"`
 	PullRequestId     string            `json:"pull_request_id"`
 	PullRequestName   string            `json:"pull_request_name"`
 	Status            PullRequestStatus `json:"status"`
+	TeamName          *string           `json:"team_name,omitempty"`
+
+	// Version Версия PR, совпадает с ETag
+	Version int64 `json:"version"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestDetails defines model for PullRequestDetails.
+type PullRequestDetails struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string                 `json:"assigned_reviewers"`
+	AuthorId          string                   `json:"author_id"`
+	AuthorUsername    string                   `json:"author_username"`
+	CreatedAt         *time.Time               `json:"created_at"`
+	Files             *[]string                `json:"files,omitempty"`
+	Labels            *[]string                `json:"labels,omitempty"`
+	MergedAt          *time.Time               `json:"merged_at"`
+	PullRequestId     string                   `json:"pull_request_id"`
+	PullRequestName   string                   `json:"pull_request_name"`
+	Reviewers         []Reviewer               `json:"reviewers"`
+	Status            PullRequestDetailsStatus `json:"status"`
+	TeamName          *string                  `json:"team_name,omitempty"`
+
+	// Version Версия PR, совпадает с ETag
+	Version int64 `json:"version"`
+}
+
+// PullRequestDetailsStatus defines model for PullRequestDetails.Status.
+type PullRequestDetailsStatus string
+
+// PullRequestResponse defines model for PullRequestResponse.
+type PullRequestResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
+	AgeSeconds     int64  `json:"age_seconds"`
+	AuthorId       string `json:"author_id"`
+	AuthorUsername string `json:"author_username"`
+
+	// CoReviewers Другие ревьюверы PR
+	CoReviewers     []string               `json:"co_reviewers"`
+	CreatedAt       *time.Time             `json:"created_at,omitempty"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
 	Status          PullRequestShortStatus `json:"status"`
@@ -71,6 +211,34 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReassignedReview defines model for ReassignedReview.
+type ReassignedReview struct {
+	// NewReviewerId Пустой, если замену найти не удалось и ревьювер снят
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// Reviewer defines model for Reviewer.
+type Reviewer struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// Rule defines model for Rule.
+type Rule struct {
+	// Pattern Шаблон пути в синтаксисе CODEOWNERS
+	Pattern   string   `json:"pattern"`
+	RuleId    int64    `json:"rule_id"`
+	TeamNames []string `json:"team_names"`
+	UserIds   []string `json:"user_ids"`
+}
+
+// RulesResponse defines model for RulesResponse.
+type RulesResponse struct {
+	Rules []Rule `json:"rules"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
@@ -79,72 +247,348 @@ type Team struct {
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// Role По умолчанию member
+	Role     *TeamMemberRole `json:"role,omitempty"`
+	UserId   string          `json:"user_id"`
+	Username string          `json:"username"`
+}
+
+// TeamMemberRole По умолчанию member
+type TeamMemberRole string
+
+// TeamSummary defines model for TeamSummary.
+type TeamSummary struct {
+	ActiveMembersCount int    `json:"active_members_count"`
+	MembersCount       int    `json:"members_count"`
+	OpenPrsCount       int    `json:"open_prs_count"`
+	TeamName           string `json:"team_name"`
+}
+
+// TeamUpdateResult defines model for TeamUpdateResult.
+type TeamUpdateResult struct {
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+	Team              Team               `json:"team"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	Expertise []string `json:"expertise"`
+	IsActive  bool     `json:"is_active"`
+
+	// TeamName Первая по имени команда пользователя
+	TeamName  string   `json:"team_name"`
+	TeamNames []string `json:"team_names"`
+	UserId    string   `json:"user_id"`
+	Username  string   `json:"username"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	User User `json:"user"`
 }
 
+// IfMatchHeader defines model for IfMatchHeader.
+type IfMatchHeader = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// OffsetQuery defines model for OffsetQuery.
+type OffsetQuery = int
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = ErrorResponse
+
+// ImportRulesTextBody defines parameters for ImportRules.
+type ImportRulesTextBody = string
+
+// ResolveOwnersJSONBody defines parameters for ResolveOwners.
+type ResolveOwnersJSONBody struct {
+	// Codeowners Содержимое CODEOWNERS; если передано, сохранённые правила не используются
+	Codeowners *string  `json:"codeowners,omitempty"`
+	Paths      []string `json:"paths"`
 }
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+// DeleteRuleParams defines parameters for DeleteRule.
+type DeleteRuleParams struct {
+	RuleId int64 `form:"rule_id" json:"rule_id"`
+}
+
+// CreateRuleJSONBody defines parameters for CreateRule.
+type CreateRuleJSONBody struct {
+	Pattern   string    `json:"pattern"`
+	TeamNames *[]string `json:"team_names,omitempty"`
+	UserIds   *[]string `json:"user_ids,omitempty"`
+}
+
+// AddReviewerParams defines parameters for AddReviewer.
+type AddReviewerParams struct {
+	// IfMatch Версия PR из ETag; изменение применяется, только если PR не менялся
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	AuthorId string `json:"author_id"`
+
+	// ExcludedReviewers Пользователи, которых нельзя назначать
+	ExcludedReviewers *[]string `json:"excluded_reviewers,omitempty"`
+
+	// Files Изменённые файлы для выбора владельцев кода
+	Files *[]string `json:"files,omitempty"`
+
+	// Labels Метки для выбора ревьюверов по экспертизе
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// RequestedReviewers Ревьюверы, назначаемые первыми (не больше двух)
+	RequestedReviewers *[]string `json:"requested_reviewers,omitempty"`
+
+	// TeamName Команда PR; по умолчанию единственная команда автора
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetPullRequestParams defines parameters for GetPullRequest.
+type GetPullRequestParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// ListPullRequestsParams defines parameters for ListPullRequests.
+type ListPullRequestsParams struct {
+	AuthorId   *string                       `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *string                       `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	TeamName   *string                       `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status     *ListPullRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedFrom Начало периода создания, включительно
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Конец периода создания, исключительно
+	CreatedTo *time.Time                   `form:"created_to,omitempty" json:"created_to,omitempty"`
+	Sort      *ListPullRequestsParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order     *ListPullRequestsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50, максимум 100
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
+// ListPullRequestsParamsStatus defines parameters for ListPullRequests.
+type ListPullRequestsParamsStatus string
+
+// ListPullRequestsParamsSort defines parameters for ListPullRequests.
+type ListPullRequestsParamsSort string
+
+// ListPullRequestsParamsOrder defines parameters for ListPullRequests.
+type ListPullRequestsParamsOrder string
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
+// MergePullRequestParams defines parameters for MergePullRequest.
+type MergePullRequestParams struct {
+	// IfMatch Версия PR из ETag; изменение применяется, только если PR не менялся
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// ReassignReviewerJSONBody defines parameters for ReassignReviewer.
+type ReassignReviewerJSONBody struct {
+	// NewReviewerId Новый ревьювер; если не указан, выбирается случайно
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// ReassignReviewerParams defines parameters for ReassignReviewer.
+type ReassignReviewerParams struct {
+	// IfMatch Версия PR из ETag; изменение применяется, только если PR не менялся
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// RemoveReviewerParams defines parameters for RemoveReviewer.
+type RemoveReviewerParams struct {
+	// IfMatch Версия PR из ETag; изменение применяется, только если PR не менялся
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// BulkDeactivateTeamUsersJSONBody defines parameters for BulkDeactivateTeamUsers.
+type BulkDeactivateTeamUsersJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// DeleteTeamParams defines parameters for DeleteTeam.
+type DeleteTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
+// ImportTeamsParams defines parameters for ImportTeams.
+type ImportTeamsParams struct {
+	// Format Формат тела; по умолчанию определяется по Content-Type
+	Format *ImportTeamsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun Только посчитать изменения, не применяя их
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportTeamsParamsFormat defines parameters for ImportTeams.
+type ImportTeamsParamsFormat string
+
+// ListTeamsParams defines parameters for ListTeams.
+type ListTeamsParams struct {
+	// Prefix Начало имени команды
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50, максимум 100
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько записей пропустить
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RemoveMembersJSONBody defines parameters for RemoveMembers.
+type RemoveMembersJSONBody struct {
+	TeamName string   `json:"team_name"`
+	UserIds  []string `json:"user_ids"`
+}
+
+// RenameTeamJSONBody defines parameters for RenameTeam.
+type RenameTeamJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetReviewParams defines parameters for GetReview.
+type GetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Status Статусы через запятую, например OPEN,MERGED; по умолчанию OPEN
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Order Порядок по дате создания PR, по умолчанию asc (сначала старые)
+	Order *GetReviewParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50, максимум 100
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько записей пропустить
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetReviewParamsOrder defines parameters for GetReview.
+type GetReviewParamsOrder string
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	TeamName       *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	IsActive       *bool   `form:"is_active,omitempty" json:"is_active,omitempty"`
+	UsernamePrefix *string `form:"username_prefix,omitempty" json:"username_prefix,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50, максимум 100
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько записей пропустить
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SetExpertiseJSONBody defines parameters for SetExpertise.
+type SetExpertiseJSONBody struct {
+	Expertise []string `json:"expertise"`
+	UserId    string   `json:"user_id"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
+// SetIsActiveJSONBody defines parameters for SetIsActive.
+type SetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+// UpdateUserJSONBody defines parameters for UpdateUser.
+type UpdateUserJSONBody struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// ImportRulesTextRequestBody defines body for ImportRules for text/plain ContentType.
+type ImportRulesTextRequestBody = ImportRulesTextBody
+
+// ResolveOwnersJSONRequestBody defines body for ResolveOwners for application/json ContentType.
+type ResolveOwnersJSONRequestBody ResolveOwnersJSONBody
+
+// CreateRuleJSONRequestBody defines body for CreateRule for application/json ContentType.
+type CreateRuleJSONRequestBody CreateRuleJSONBody
+
+// AddReviewerJSONRequestBody defines body for AddReviewer for application/json ContentType.
+type AddReviewerJSONRequestBody = ChangeReviewerRequest
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignReviewerJSONRequestBody defines body for ReassignReviewer for application/json ContentType.
+type ReassignReviewerJSONRequestBody ReassignReviewerJSONBody
+
+// RemoveReviewerJSONRequestBody defines body for RemoveReviewer for application/json ContentType.
+type RemoveReviewerJSONRequestBody = ChangeReviewerRequest
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = Team
+
+// AddMembersJSONRequestBody defines body for AddMembers for application/json ContentType.
+type AddMembersJSONRequestBody = Team
+
+// BulkDeactivateTeamUsersJSONRequestBody defines body for BulkDeactivateTeamUsers for application/json ContentType.
+type BulkDeactivateTeamUsersJSONRequestBody BulkDeactivateTeamUsersJSONBody
+
+// RemoveMembersJSONRequestBody defines body for RemoveMembers for application/json ContentType.
+type RemoveMembersJSONRequestBody RemoveMembersJSONBody
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+// RenameTeamJSONRequestBody defines body for RenameTeam for application/json ContentType.
+type RenameTeamJSONRequestBody RenameTeamJSONBody
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+// SetExpertiseJSONRequestBody defines body for SetExpertise for application/json ContentType.
+type SetExpertiseJSONRequestBody SetExpertiseJSONBody
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+// SetIsActiveJSONRequestBody defines body for SetIsActive for application/json ContentType.
+type SetIsActiveJSONRequestBody SetIsActiveJSONBody
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody UpdateUserJSONBody
@@ -5,25 +5,63 @@ import (
 	"net/http"
 
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
+// Error тело ErrorResponse; RequestID заполняется только для 500, чтобы найти запрос в логах,
+// Fields - для ошибок проверки тела запроса
 type Error struct {
-	Code    models.ErrorResponseErrorCode `json:"code"`
-	Message string                        `json:"message"`
+	Code      models.ErrorResponseErrorCode `json:"code"`
+	Message   string                        `json:"message"`
+	RequestID string                        `json:"request_id,omitempty"`
+	Fields    []validation.FieldError       `json:"fields,omitempty"`
+}
+
+type errorResponse struct {
+	Error Error `json:"error"`
 }
 
 func SendError(c *gin.Context, statusCode int, response Error) {
-	c.JSON(statusCode, models.ErrorResponse{Error: response})
+	c.JSON(statusCode, errorResponse{Error: response})
+}
+
+// SendBindError отвечает 400 на ошибку разбора тела запроса; если не прошла проверка полей,
+// они перечисляются в fields
+func SendBindError(c *gin.Context, err error) {
+	response := Error{
+		Code:    "INVALID_REQUEST",
+		Message: err.Error(),
+	}
+	if fields := validation.FieldErrors(err); len(fields) > 0 {
+		response.Message = "request validation failed"
+		response.Fields = fields
+	}
+	SendError(c, http.StatusBadRequest, response)
+}
+
+// SendParamError ErrorHandler сгенерированного сервера: отвечает INVALID_REQUEST на отсутствующий
+// или некорректный параметр запроса
+func SendParamError(c *gin.Context, err error, statusCode int) {
+	SendError(c, statusCode, Error{
+		Code:    "INVALID_REQUEST",
+		Message: err.Error(),
+	})
+}
+
+// Deref значение необязательного параметра запроса или нулевое значение, если параметр не передан
+func Deref[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+	return *value
 }
 
 func SendOk(c *gin.Context, response any) {
 	_, err := json.Marshal(response)
 	if err != nil {
-		SendError(c, http.StatusInternalServerError, Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
@@ -33,10 +71,7 @@ func SendOk(c *gin.Context, response any) {
 func SendCreated(c *gin.Context, response any) {
 	_, err := json.Marshal(response)
 	if err != nil {
-		SendError(c, http.StatusInternalServerError, Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BasePath префикс текущей версии API; пути в спецификации указаны без него
+const BasePath = "/api/v1"
+
+// Operation путь операции из спецификации: маршрут без префикса версии. У маршрута /api/v1 и его
+// устаревшего псевдонима в корне он совпадает, например для лимитов частоты запросов
+func Operation(c *gin.Context) string {
+	return strings.TrimPrefix(c.FullPath(), BasePath)
+}
+
+// Deprecated помечает устаревшие маршруты в корне заголовком Deprecation и ссылкой на замену
+// в текущей версии API
+func Deprecated() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Deprecation", "true")
+		if path := c.FullPath(); path != "" {
+			c.Header("Link", "<"+BasePath+path+`>; rel="successor-version"`)
+		}
+		c.Next()
+	}
+}
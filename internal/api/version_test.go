@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionedRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name              string
+		path              string
+		expectedOperation string
+		expectedHeaders   map[string]string
+	}{
+		{
+			name:              "current version",
+			path:              "/api/v1/team/get",
+			expectedOperation: "/team/get",
+			expectedHeaders:   map[string]string{"Deprecation": "", "Link": ""},
+		},
+		{
+			name:              "legacy alias",
+			path:              "/team/get",
+			expectedOperation: "/team/get",
+			expectedHeaders: map[string]string{
+				"Deprecation": "true",
+				"Link":        `</api/v1/team/get>; rel="successor-version"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var operation string
+			handler := func(c *gin.Context) {
+				operation = Operation(c)
+				c.Status(http.StatusOK)
+			}
+
+			router := gin.New()
+			router.Group(BasePath).GET("/team/get", handler)
+			router.Group("", Deprecated()).GET("/team/get", handler)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedOperation, operation)
+			for k, v := range tt.expectedHeaders {
+				assert.Equal(t, v, w.Header().Get(k), k)
+			}
+		})
+	}
+}
@@ -1,41 +1,126 @@
 package apiserver
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"sync"
+	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/docs"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/owners"
 	"github.com/aabbuukkaarr8/PRService/internal/handler/pullrequest"
 	"github.com/aabbuukkaarr8/PRService/internal/handler/user"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
 
 	"github.com/aabbuukkaarr8/PRService/internal/handler/team"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 type APIServer struct {
 	config *Config
 	logger *logrus.Logger
 	router *gin.Engine
+
+	workersCtx  context.Context
+	stopWorkers context.CancelFunc
+	workersWG   sync.WaitGroup
 }
 
+// New создает сервер с JSON-логгером. Каждый запрос получает span (с родителем из traceparent),
+// X-Request-ID и логгер с ними в контексте, по завершении запроса пишется строка access-лога
 func New(config *Config) *APIServer {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
+	logger.SetFormatter(&logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano})
+
+	router := gin.New()
+	// до Run заголовкам X-Forwarded-For не доверяется, список прокси из конфига применяется в Run
+	_ = router.SetTrustedProxies(nil)
+	router.Use(otelgin.Middleware(config.Tracing.ServiceName), logging.Middleware(logger), logging.Recovery())
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	workersCtx = logging.WithLogger(workersCtx, logrus.NewEntry(logger))
 	return &APIServer{
-		config: config,
-		logger: logger,
-		router: gin.Default(),
+		config:      config,
+		logger:      logger,
+		router:      router,
+		workersCtx:  workersCtx,
+		stopWorkers: stopWorkers,
 	}
 
 }
 
-func (s *APIServer) Run() error {
+// Run запускает HTTP сервер и блокируется до отмены ctx.
+// После отмены сервер перестает принимать новые соединения и дожидается
+// завершения текущих запросов в течение ShutdownTimeout, затем останавливает фоновые воркеры.
+func (s *APIServer) Run(ctx context.Context) error {
 	if err := s.configLogger(); err != nil {
 		return err
 	}
+	if err := s.router.SetTrustedProxies(s.config.TrustedProxies); err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:         s.config.BindAddr,
+		Handler:      s.router,
+		ReadTimeout:  s.config.ReadTimeout,
+		WriteTimeout: s.config.WriteTimeout,
+		IdleTimeout:  s.config.IdleTimeout,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.WithField("bind_addr", s.config.BindAddr).Info("Starting API server")
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		s.shutdownWorkers()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+	}
 
-	s.logger.Info("Starting API server")
-	return http.ListenAndServe(s.config.BindAddr, s.router)
+	s.logger.WithField("timeout", s.config.ShutdownTimeout).Info("Shutting down API server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+
+	err := srv.Shutdown(shutdownCtx)
+	if err != nil {
+		s.logger.WithError(err).Warn("Graceful shutdown timed out, closing remaining connections")
+		_ = srv.Close()
+	}
+
+	s.shutdownWorkers()
+	s.logger.Info("API server stopped")
+
+	return err
+}
+
+// Go запускает фоновый воркер, привязанный к жизненному циклу сервера.
+// Контекст воркера отменяется при остановке сервера, Run дожидается его завершения.
+func (s *APIServer) Go(worker func(ctx context.Context)) {
+	s.workersWG.Add(1)
+	go func() {
+		defer s.workersWG.Done()
+		worker(s.workersCtx)
+	}()
+}
+
+func (s *APIServer) shutdownWorkers() {
+	s.stopWorkers()
+	s.workersWG.Wait()
 }
 
 func (s *APIServer) configLogger() error {
@@ -47,16 +132,31 @@ func (s *APIServer) configLogger() error {
 	return nil
 }
 
-func (s *APIServer) ConfigureRouter(teamHandler *team.Handler, usersHandler *user.Handler, prHandler *pullrequest.Handler) {
-	s.router.POST("/team/add", teamHandler.CreateTeam)
-	s.router.GET("/team/get", teamHandler.GetTeam)
-	s.router.POST("/users/setIsActive", usersHandler.SetIsActive)
-	s.router.GET("/users/getReview", usersHandler.GetReview)
-	s.router.POST("/pullRequest/create", prHandler.CreatePullRequest)
-	s.router.POST("/pullRequest/merge", prHandler.MergePullRequest)
-	s.router.POST("/pullRequest/reassign", prHandler.ReassignReviewer)
-	s.router.GET("/stats", prHandler.GetStats)
-	s.router.POST("/team/bulkDeactivate", prHandler.BulkDeactivateTeamUsers)
+// Use добавляет middleware ко всем маршрутам; вызывается до ConfigureRouter
+func (s *APIServer) Use(middleware ...gin.HandlerFunc) {
+	s.router.Use(middleware...)
+}
+
+// ConfigureRouter регистрирует маршруты из спецификации openApi/openapi 2.yml под /api/v1; прежние пути в корне
+// остаются устаревшими псевдонимами с заголовком Deprecation. Обработчик ошибок подключается здесь, после
+// middleware из Use, чтобы ответы на ошибки хендлеров проходили через них (например, сохранялись
+// для Idempotency-Key). Спецификация и Swagger UI отдаются на /openapi.yaml, /openapi.json и /docs
+func (s *APIServer) ConfigureRouter(teamHandler *team.Handler, usersHandler *user.Handler, prHandler *pullrequest.Handler, ownersHandler *owners.Handler) {
+	s.router.Use(api.ErrorMiddleware(s.logger))
+
+	si := server{
+		teamAPI:        teamHandler,
+		userAPI:        usersHandler,
+		pullRequestAPI: prHandler,
+		ownersAPI:      ownersHandler,
+	}
+	options := models.GinServerOptions{
+		ErrorHandler: api.SendParamError,
+	}
+	models.RegisterHandlersWithOptions(s.router.Group(api.BasePath), si, options)
+	models.RegisterHandlersWithOptions(s.router.Group("", api.Deprecated()), si, options)
+
+	docs.NewHandler().Register(s.router)
 }
 
 func (s *APIServer) GetRouter() *gin.Engine {
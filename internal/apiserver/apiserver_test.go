@@ -0,0 +1,123 @@
+package apiserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIServer_RunGracefulShutdown(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	config := NewConfig()
+	config.BindAddr = addr
+	config.LogLevel = "error"
+	config.ShutdownTimeout = 5 * time.Second
+
+	s := New(config)
+
+	requestStarted := make(chan struct{})
+	s.router.GET("/slow", func(c *gin.Context) {
+		close(requestStarted)
+		time.Sleep(200 * time.Millisecond)
+		c.String(http.StatusOK, "done")
+	})
+
+	workerStopped := make(chan struct{})
+	s.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		close(workerStopped)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- s.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 2*time.Second, 10*time.Millisecond)
+
+	respCh := make(chan *http.Response, 1)
+	go func() {
+		r, err := http.Get("http://" + addr + "/slow")
+		if err != nil {
+			respCh <- nil
+			return
+		}
+		respCh <- r
+	}()
+
+	<-requestStarted
+	cancel()
+
+	resp := <-respCh
+	require.NotNil(t, resp, "in-flight request must be drained, not dropped")
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "done", string(body))
+
+	select {
+	case err := <-runErr:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after context cancellation")
+	}
+
+	select {
+	case <-workerStopped:
+	default:
+		t.Fatal("background worker was not stopped")
+	}
+}
+
+func TestAPIServer_ClientIPIgnoresForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	config := NewConfig()
+	config.LogLevel = "error"
+	s := New(config)
+
+	var clientIP string
+	s.router.GET("/ip", func(c *gin.Context) {
+		clientIP = c.ClientIP()
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	req.Header.Set("X-Real-IP", "198.51.100.2")
+	s.router.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "192.0.2.1", clientIP)
+}
+
+func TestAPIServer_RunInvalidTrustedProxies(t *testing.T) {
+	config := NewConfig()
+	config.LogLevel = "error"
+	config.TrustedProxies = []string{"not-an-ip"}
+
+	err := New(config).Run(context.Background())
+	assert.Error(t, err)
+}
@@ -1,17 +1,59 @@
 package apiserver
 
-import "github.com/aabbuukkaarr8/PRService/internal/store"
+import (
+	"time"
+
+	"github.com/aabbuukkaarr8/PRService/internal/ratelimit"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"github.com/aabbuukkaarr8/PRService/internal/validation"
+)
 
 type Config struct {
-	BindAddr string `toml:"bind_addr"`
-	LogLevel string `toml:"log_level"`
-	Store    *store.Config
+	BindAddr        string        `toml:"bind_addr"`
+	LogLevel        string        `toml:"log_level"`
+	ReadTimeout     time.Duration `toml:"read_timeout"`
+	WriteTimeout    time.Duration `toml:"write_timeout"`
+	IdleTimeout     time.Duration `toml:"idle_timeout"`
+	ShutdownTimeout time.Duration `toml:"shutdown_timeout"`
+	// TrustedProxies адреса и подсети прокси, которым доверяются X-Forwarded-For и X-Real-IP;
+	// по умолчанию не доверяется никому и клиентом считается адрес соединения
+	TrustedProxies []string `toml:"trusted_proxies"`
+	Idempotency    *IdempotencyConfig
+	RateLimit      *ratelimit.Config
+	Tracing        *tracing.Config
+	Validation     *validation.Config
+	Store          *store.Config
 }
 
 func NewConfig() *Config {
 	return &Config{
-		BindAddr: ":8080",
-		LogLevel: "debug",
-		Store:    store.NewConfig(),
+		BindAddr:        ":8080",
+		LogLevel:        "debug",
+		ReadTimeout:     10 * time.Second,
+		WriteTimeout:    30 * time.Second,
+		IdleTimeout:     60 * time.Second,
+		ShutdownTimeout: 30 * time.Second,
+		Idempotency:     NewIdempotencyConfig(),
+		RateLimit:       ratelimit.NewConfig(),
+		Tracing:         tracing.NewConfig(),
+		Validation:      validation.NewConfig(),
+		Store:           store.NewConfig(),
+	}
+}
+
+// IdempotencyConfig сколько хранятся ответы для Idempotency-Key и как часто удаляются истекшие.
+// Lease - сколько ключ держит выполняющийся запрос; должен быть больше времени обработки запроса
+type IdempotencyConfig struct {
+	TTL             time.Duration `toml:"ttl"`
+	Lease           time.Duration `toml:"lease"`
+	CleanupInterval time.Duration `toml:"cleanup_interval"`
+}
+
+func NewIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		TTL:             24 * time.Hour,
+		Lease:           time.Minute,
+		CleanupInterval: time.Hour,
 	}
 }
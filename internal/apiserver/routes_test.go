@@ -0,0 +1,81 @@
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/docs"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/owners"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/team"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/user"
+	"github.com/aabbuukkaarr8/PRService/openApi"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// specRoutes операции спецификации в виде "METHOD /path"
+func specRoutes(t *testing.T) []string {
+	t.Helper()
+
+	var spec struct {
+		Paths map[string]map[string]any `yaml:"paths"`
+	}
+	require.NoError(t, yaml.Unmarshal(openapi.Spec, &spec))
+
+	methods := map[string]bool{
+		http.MethodGet: true, http.MethodPost: true, http.MethodPut: true,
+		http.MethodPatch: true, http.MethodDelete: true,
+	}
+
+	var routes []string
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			if method = strings.ToUpper(method); methods[method] {
+				routes = append(routes, method+" "+path)
+			}
+		}
+	}
+	return routes
+}
+
+// TestRoutesMatchSpec проверяет, что каждая операция есть под /api/v1 и в корне; падает, если маршрут добавлен мимо спецификации или спецификация
+// изменена без make generate
+func TestRoutesMatchSpec(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	config := NewConfig()
+	config.LogLevel = "error"
+	s := New(config)
+	s.ConfigureRouter(
+		team.NewHandler(nil, s.logger),
+		user.NewHandler(nil, s.logger),
+		pullrequest.NewHandler(nil, s.logger),
+		owners.NewHandler(nil, s.logger),
+	)
+
+	var routes []string
+	for _, route := range s.router.Routes() {
+		routes = append(routes, route.Method+" "+route.Path)
+	}
+
+	operations := specRoutes(t)
+	require.NotEmpty(t, operations)
+
+	var expected []string
+	for _, operation := range operations {
+		method, path, _ := strings.Cut(operation, " ")
+		expected = append(expected, method+" "+api.BasePath+path, operation)
+	}
+	expected = append(expected,
+		"GET "+docs.YAMLPath,
+		"GET "+docs.JSONPath,
+		"GET "+docs.UIPath,
+		"GET "+docs.UIPath+"/*filepath",
+	)
+	assert.ElementsMatch(t, expected, routes)
+}
@@ -0,0 +1,27 @@
+package apiserver
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/owners"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/team"
+	"github.com/aabbuukkaarr8/PRService/internal/handler/user"
+)
+
+// Псевдонимы нужны, чтобы встроить одноименные типы Handler в server
+type (
+	teamAPI        = team.Handler
+	userAPI        = user.Handler
+	pullRequestAPI = pullrequest.Handler
+	ownersAPI      = owners.Handler
+)
+
+// server собирает хендлеры пакетов в реализацию сгенерированного models.ServerInterface
+type server struct {
+	*teamAPI
+	*userAPI
+	*pullRequestAPI
+	*ownersAPI
+}
+
+var _ models.ServerInterface = server{}
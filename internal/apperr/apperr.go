@@ -0,0 +1,46 @@
+// Package apperr типизированные ошибки предметной области.
+// Сервисы объявляют их сентинелями и дополняют подробностями через fmt.Errorf("%w: ..."),
+// слой HTTP выбирает статус по Kind и отдает клиенту Code и текст ошибки
+package apperr
+
+import "errors"
+
+// Kind класс ошибки, по нему выбирается HTTP-статус ответа
+type Kind int
+
+const (
+	// KindInvalid некорректный запрос, 400
+	KindInvalid Kind = iota + 1
+	// KindNotFound объект не найден, 404
+	KindNotFound
+	// KindConflict запрос противоречит текущему состоянию, 409
+	KindConflict
+	// KindPreconditionFailed не выполнено условие запроса (If-Match), 412
+	KindPreconditionFailed
+)
+
+// Error ошибка предметной области. Message - текст для клиента, в Error() возвращается он же,
+// поэтому обертка fmt.Errorf("%w: ...") дает клиенту сообщение с подробностями
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+}
+
+// New создает ошибку; вызывается при объявлении переменных-сентинелей
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// As возвращает ошибку предметной области из цепочки err
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
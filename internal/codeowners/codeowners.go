@@ -0,0 +1,119 @@
+// Package codeowners разбирает файлы CODEOWNERS: шаблоны путей в синтаксисе gitignore
+// и владельцев в виде @user, @org/team.
+package codeowners
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrInvalidPattern = errors.New("invalid pattern")
+	ErrInvalidOwner   = errors.New("invalid owner")
+	ErrSyntax         = errors.New("invalid CODEOWNERS")
+)
+
+// Rule строка CODEOWNERS: шаблон и владельцы в том виде, как они записаны в файле
+type Rule struct {
+	Pattern string
+	Owners  []string
+	Line    int
+}
+
+// Owner владелец из CODEOWNERS: пользователь (@user) или команда (@org/team, организация отбрасывается)
+type Owner struct {
+	UserID   string
+	TeamName string
+}
+
+// Parse читает CODEOWNERS: пустые строки и комментарии (#, кроме экранированного \#) пропускаются,
+// каждая строка - шаблон и владельцы через пробел. Шаблоны и владельцы проверяются;
+// у отрицания (!pattern) владельцев быть не может.
+func Parse(r io.Reader) ([]Rule, error) {
+	var rules []Rule
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(stripComment(scanner.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern, err := CompilePattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrSyntax, line, err)
+		}
+		if pattern.Negated() && len(fields) > 1 {
+			return nil, fmt.Errorf("%w: line %d: %w: %q, negated pattern cannot have owners", ErrSyntax, line, ErrInvalidPattern, fields[0])
+		}
+		for _, handle := range fields[1:] {
+			if _, err := ParseOwner(handle); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrSyntax, line, err)
+			}
+		}
+
+		rules = append(rules, Rule{
+			Pattern: fields[0],
+			Owners:  fields[1:],
+			Line:    line,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// stripComment отрезает комментарий, начинающийся с неэкранированного #
+func stripComment(text string) string {
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '#':
+			return text[:i]
+		}
+	}
+	return text
+}
+
+// SplitOwners разбирает владельцев правила на пользователей и команды
+func SplitOwners(handles []string) (userIDs, teamNames []string, err error) {
+	for _, handle := range handles {
+		owner, err := ParseOwner(handle)
+		if err != nil {
+			return nil, nil, err
+		}
+		if owner.TeamName != "" {
+			teamNames = append(teamNames, owner.TeamName)
+		} else {
+			userIDs = append(userIDs, owner.UserID)
+		}
+	}
+	return userIDs, teamNames, nil
+}
+
+// ParseOwner разбирает владельца: @user - пользователь, @org/team - команда team
+func ParseOwner(handle string) (Owner, error) {
+	name, ok := strings.CutPrefix(handle, "@")
+	if !ok || name == "" {
+		return Owner{}, fmt.Errorf("%w: %q, expected @user or @org/team", ErrInvalidOwner, handle)
+	}
+
+	if org, team, isTeam := strings.Cut(name, "/"); isTeam {
+		if org == "" || team == "" || strings.Contains(team, "/") {
+			return Owner{}, fmt.Errorf("%w: %q, expected @org/team", ErrInvalidOwner, handle)
+		}
+		return Owner{TeamName: team}, nil
+	}
+
+	return Owner{UserID: name}, nil
+}
@@ -0,0 +1,178 @@
+package codeowners
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedResult []Rule
+		expectedError  string
+	}{
+		{
+			name: "rules with comments and blank lines",
+			input: `# default owners
+*       @org/backend
+
+/docs/  @alice @bob   # documentation
+*.go    @org/go-reviewers
+/build/logs/
+`,
+			expectedResult: []Rule{
+				{Pattern: "*", Owners: []string{"@org/backend"}, Line: 2},
+				{Pattern: "/docs/", Owners: []string{"@alice", "@bob"}, Line: 4},
+				{Pattern: "*.go", Owners: []string{"@org/go-reviewers"}, Line: 5},
+				{Pattern: "/build/logs/", Owners: []string{}, Line: 6},
+			},
+		},
+		{
+			name:           "empty file",
+			input:          "# nothing here\n\n",
+			expectedResult: nil,
+		},
+		{
+			name:          "email owner",
+			input:         "*.js  docs@example.com\n",
+			expectedError: "line 1: invalid owner",
+		},
+		{
+			name:  "negation and escaped hash",
+			input: "*  @alice\n!docs/\n\\#notes/  @bob  # escaped\n",
+			expectedResult: []Rule{
+				{Pattern: "*", Owners: []string{"@alice"}, Line: 1},
+				{Pattern: "!docs/", Owners: []string{}, Line: 2},
+				{Pattern: "\\#notes/", Owners: []string{"@bob"}, Line: 3},
+			},
+		},
+		{
+			name:          "negation with owners",
+			input:         "*  @alice\n!docs/  @bob\n",
+			expectedError: "line 2: invalid pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(strings.NewReader(tt.input))
+
+			if tt.expectedError != "" {
+				assert.ErrorIs(t, err, ErrSyntax)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestParseOwner(t *testing.T) {
+	tests := []struct {
+		handle        string
+		expected      Owner
+		expectedError bool
+	}{
+		{handle: "@alice", expected: Owner{UserID: "alice"}},
+		{handle: "@org/backend", expected: Owner{TeamName: "backend"}},
+		{handle: "alice", expectedError: true},
+		{handle: "@", expectedError: true},
+		{handle: "@org/", expectedError: true},
+		{handle: "@org/a/b", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.handle, func(t *testing.T) {
+			owner, err := ParseOwner(tt.handle)
+
+			if tt.expectedError {
+				assert.ErrorIs(t, err, ErrInvalidOwner)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, owner)
+		})
+	}
+}
+
+func TestSplitOwners(t *testing.T) {
+	userIDs, teamNames, err := SplitOwners([]string{"@alice", "@org/backend", "@bob"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, userIDs)
+	assert.Equal(t, []string{"backend"}, teamNames)
+
+	_, _, err = SplitOwners([]string{"@alice", "bob"})
+	assert.ErrorIs(t, err, ErrInvalidOwner)
+}
+
+func TestPattern_Match(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "*", path: "main.go", expected: true},
+		{pattern: "*", path: "internal/api/utils.go", expected: true},
+		{pattern: "*.go", path: "internal/api/utils.go", expected: true},
+		{pattern: "*.go", path: "README.md", expected: false},
+		{pattern: "/docs/", path: "docs/api/index.md", expected: true},
+		{pattern: "/docs/", path: "internal/docs/index.md", expected: false},
+		{pattern: "/docs/", path: "docs", expected: false},
+		{pattern: "docs/", path: "docs/index.md", expected: true},
+		{pattern: "apps/", path: "src/apps/main.go", expected: true},
+		{pattern: "apps", path: "src/apps/main.go", expected: true},
+		{pattern: "/Makefile", path: "Makefile", expected: true},
+		{pattern: "/Makefile", path: "build/Makefile", expected: false},
+		{pattern: "internal/*/dto.go", path: "internal/team/dto.go", expected: true},
+		{pattern: "internal/*/dto.go", path: "internal/team/sub/dto.go", expected: false},
+		{pattern: "internal/**/dto.go", path: "internal/team/sub/dto.go", expected: true},
+		{pattern: "internal/**/dto.go", path: "internal/dto.go", expected: true},
+		{pattern: "**/migrations", path: "db/migrations/00001.sql", expected: true},
+		{pattern: "db/**", path: "db/insert/data.sql", expected: true},
+		{pattern: "file?.txt", path: "file1.txt", expected: true},
+		{pattern: "file?.txt", path: "file10.txt", expected: false},
+		{pattern: "a.b", path: "axb", expected: false},
+		{pattern: "/docs/", path: "/docs/index.md", expected: true},
+		{pattern: "!docs/", path: "docs/index.md", expected: true},
+		{pattern: `\#notes`, path: "#notes", expected: true},
+		{pattern: `\!important.txt`, path: "!important.txt", expected: true},
+		{pattern: `docs/\#notes`, path: "docs/#notes", expected: true},
+		{pattern: `docs/\#notes`, path: `docs/\#notes`, expected: false},
+		{pattern: `notes/draft\!.md`, path: "notes/draft!.md", expected: true},
+		{pattern: `!docs/\#drafts/`, path: "docs/#drafts/a.md", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			p, err := CompilePattern(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, p.Match(tt.path))
+		})
+	}
+}
+
+func TestCompilePattern_Negated(t *testing.T) {
+	p, err := CompilePattern("!docs/")
+	require.NoError(t, err)
+	assert.True(t, p.Negated())
+
+	p, err = CompilePattern(`\!docs/`)
+	require.NoError(t, err)
+	assert.False(t, p.Negated())
+}
+
+func TestCompilePattern_Invalid(t *testing.T) {
+	for _, pattern := range []string{"", "/", "!", "!/"} {
+		t.Run(pattern, func(t *testing.T) {
+			_, err := CompilePattern(pattern)
+			assert.ErrorIs(t, err, ErrInvalidPattern)
+		})
+	}
+}
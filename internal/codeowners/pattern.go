@@ -0,0 +1,84 @@
+package codeowners
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Pattern скомпилированный шаблон пути
+type Pattern struct {
+	re      *regexp.Regexp
+	negated bool
+}
+
+// CompilePattern компилирует шаблон в синтаксисе gitignore:
+//   - шаблон со слешем в начале или середине привязан к корню, без слеша - совпадает на любой глубине;
+//   - слеш в конце - только содержимое каталога;
+//   - * - любые символы кроме /, ? - один символ кроме /, ** - любое число каталогов;
+//   - шаблон, совпавший с каталогом, покрывает все файлы внутри него;
+//   - ! в начале - отрицание: совпавшие файлы остаются без владельцев; \! и \# в любом месте шаблона -
+//     буквальные символы.
+func CompilePattern(pattern string) (*Pattern, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("%w: empty pattern", ErrInvalidPattern)
+	}
+
+	body, negated := strings.CutPrefix(pattern, "!")
+
+	dirOnly := strings.HasSuffix(body, "/")
+	trimmed := strings.Trim(body, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPattern, pattern)
+	}
+	anchored := strings.HasPrefix(body, "/") || strings.Contains(trimmed, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(trimmed); i++ {
+		switch c := trimmed[i]; {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(trimmed[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case strings.HasPrefix(trimmed[i:], `\!`) || strings.HasPrefix(trimmed[i:], `\#`):
+			b.WriteString(regexp.QuoteMeta(trimmed[i+1 : i+2]))
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	if dirOnly {
+		b.WriteString("/.*$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrInvalidPattern, pattern, err)
+	}
+	return &Pattern{re: re, negated: negated}, nil
+}
+
+// Match сообщает, покрывает ли шаблон путь файла относительно корня репозитория.
+// Для отрицания проверяется шаблон без !
+func (p *Pattern) Match(path string) bool {
+	return p.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// Negated сообщает, что шаблон - отрицание (!pattern)
+func (p *Pattern) Negated() bool {
+	return p.negated
+}
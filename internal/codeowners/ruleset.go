@@ -0,0 +1,43 @@
+package codeowners
+
+import "fmt"
+
+// Ruleset шаблоны правил в порядке файла. Для пути действует последнее совпавшее правило,
+// как в CODEOWNERS; если это отрицание, у пути нет владельцев
+type Ruleset struct {
+	patterns []*Pattern
+}
+
+// NewRuleset собирает набор из уже скомпилированных шаблонов; nil-шаблоны ни с чем не совпадают
+func NewRuleset(patterns ...*Pattern) *Ruleset {
+	return &Ruleset{patterns: patterns}
+}
+
+// CompileRuleset компилирует шаблоны; в ошибке указан номер шаблона (с нуля)
+func CompileRuleset(patterns []string) (*Ruleset, error) {
+	compiled := make([]*Pattern, len(patterns))
+	for i, pattern := range patterns {
+		p, err := CompilePattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		compiled[i] = p
+	}
+	return NewRuleset(compiled...), nil
+}
+
+// Match возвращает индекс правила, определяющего владельцев пути, или -1,
+// если ни одно правило не совпало либо последним совпало отрицание
+func (rs *Ruleset) Match(path string) int {
+	for i := len(rs.patterns) - 1; i >= 0; i-- {
+		p := rs.patterns[i]
+		if p == nil || !p.Match(path) {
+			continue
+		}
+		if p.negated {
+			return -1
+		}
+		return i
+	}
+	return -1
+}
@@ -0,0 +1,45 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleset_Match(t *testing.T) {
+	rs, err := CompileRuleset([]string{"*", "/docs/", "!docs/drafts/", "*.go"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{path: "README.md", expected: 0},
+		{path: "docs/index.md", expected: 1},
+		{path: "docs/drafts/todo.md", expected: -1},
+		{path: "docs/drafts/gen.go", expected: 3},
+		{path: "internal/api/server.go", expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rs.Match(tt.path))
+		})
+	}
+}
+
+func TestRuleset_NoMatch(t *testing.T) {
+	rs, err := CompileRuleset([]string{"/docs/"})
+	require.NoError(t, err)
+	assert.Equal(t, -1, rs.Match("main.go"))
+
+	assert.Equal(t, -1, NewRuleset().Match("main.go"))
+	assert.Equal(t, -1, NewRuleset(nil).Match("main.go"))
+}
+
+func TestCompileRuleset_Invalid(t *testing.T) {
+	_, err := CompileRuleset([]string{"*", "/"})
+	assert.ErrorIs(t, err, ErrInvalidPattern)
+	assert.Contains(t, err.Error(), "rule 1")
+}
@@ -0,0 +1,64 @@
+package docs
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/aabbuukkaarr8/PRService/openApi"
+	"github.com/gin-gonic/gin"
+	"github.com/swaggest/swgui"
+	"github.com/swaggest/swgui/v5emb"
+)
+
+const (
+	YAMLPath = "/openapi.yaml"
+	JSONPath = "/openapi.json"
+	UIPath   = "/docs"
+)
+
+// Handler отдает встроенную спецификацию и Swagger UI; статика UI тоже встроена в бинарник,
+// поэтому страница работает без доступа в интернет
+type Handler struct {
+	ui http.Handler
+
+	jsonOnce sync.Once
+	json     []byte
+	jsonErr  error
+}
+
+func NewHandler() *Handler {
+	return &Handler{
+		ui: v5emb.NewHandlerWithConfig(swgui.Config{
+			Title:       "PR Reviewer Assignment Service",
+			SwaggerJSON: JSONPath,
+			BasePath:    UIPath + "/",
+		}),
+	}
+}
+
+// Register добавляет маршруты документации; они не входят в спецификацию
+func (h *Handler) Register(router gin.IRouter) {
+	router.GET(YAMLPath, h.GetYAML)
+	router.GET(JSONPath, h.GetJSON)
+	router.GET(UIPath, h.UI)
+	router.GET(UIPath+"/*filepath", h.UI)
+}
+
+func (h *Handler) GetYAML(c *gin.Context) {
+	c.Data(http.StatusOK, "application/yaml", openapi.Spec)
+}
+
+func (h *Handler) GetJSON(c *gin.Context) {
+	h.jsonOnce.Do(func() {
+		h.json, h.jsonErr = openapi.JSON()
+	})
+	if h.jsonErr != nil {
+		_ = c.Error(h.jsonErr)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", h.json)
+}
+
+func (h *Handler) UI(c *gin.Context) {
+	h.ui.ServeHTTP(c.Writer, c.Request)
+}
@@ -0,0 +1,89 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/openApi"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		expectedContentType string
+		checkBody           func(t *testing.T, body []byte)
+	}{
+		{
+			name:                "yaml spec",
+			path:                YAMLPath,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/yaml",
+			checkBody: func(t *testing.T, body []byte) {
+				assert.Equal(t, openapi.Spec, body)
+			},
+		},
+		{
+			name:                "json spec",
+			path:                JSONPath,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			checkBody: func(t *testing.T, body []byte) {
+				var spec map[string]any
+				assert.NoError(t, json.Unmarshal(body, &spec))
+				assert.Contains(t, spec, "paths")
+			},
+		},
+		{
+			name:                "ui page",
+			path:                UIPath,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html",
+			checkBody: func(t *testing.T, body []byte) {
+				assert.Contains(t, string(body), JSONPath)
+			},
+		},
+		{
+			name:                "ui page with trailing slash",
+			path:                UIPath + "/",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html",
+		},
+		{
+			name:           "bundled ui asset",
+			path:           UIPath + "/swagger-ui-bundle.js",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown asset",
+			path:           UIPath + "/missing.js",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			NewHandler().Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedContentType != "" {
+				assert.Contains(t, w.Header().Get("Content-Type"), tt.expectedContentType)
+			}
+			if tt.checkBody != nil {
+				tt.checkBody(t, w.Body.Bytes())
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package owners
+
+import (
+	"context"
+
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+)
+
+type ServiceOwners interface {
+	ListRules(ctx context.Context) ([]ownerssrv.Rule, error)
+	CreateRule(ctx context.Context, rule ownerssrv.Rule) (ownerssrv.Rule, error)
+	ImportRules(ctx context.Context, rules []ownerssrv.Rule) ([]ownerssrv.Rule, error)
+	DeleteRule(ctx context.Context, ruleID int64) error
+	ResolveOwners(ctx context.Context, paths []string) (ownerssrv.Resolution, error)
+	ResolveOwnersWithRules(ctx context.Context, rules []ownerssrv.Rule, paths []string) (ownerssrv.Resolution, error)
+}
@@ -0,0 +1,115 @@
+package owners
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/codeowners"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+)
+
+type Rule struct {
+	RuleID    int64    `json:"rule_id"`
+	Pattern   string   `json:"pattern"`
+	UserIDs   []string `json:"user_ids"`
+	TeamNames []string `json:"team_names"`
+}
+
+type CreateRuleRequest struct {
+	Pattern   string   `json:"pattern" binding:"required"`
+	UserIDs   []string `json:"user_ids" binding:"dive,required,id"`
+	TeamNames []string `json:"team_names" binding:"dive,required,name"`
+}
+
+type CreateRuleResponse struct {
+	Rule Rule `json:"rule"`
+}
+
+type ListRulesResponse struct {
+	Rules []Rule `json:"rules"`
+}
+
+type ImportRulesResponse struct {
+	Rules []Rule `json:"rules"`
+}
+
+type DeleteRuleResponse struct {
+	RuleID int64 `json:"rule_id"`
+}
+
+func (r *Rule) FillFromService(s ownerssrv.Rule) {
+	r.RuleID = s.RuleID
+	r.Pattern = s.Pattern
+	r.UserIDs = s.UserIDs
+	if r.UserIDs == nil {
+		r.UserIDs = []string{}
+	}
+	r.TeamNames = s.TeamNames
+	if r.TeamNames == nil {
+		r.TeamNames = []string{}
+	}
+}
+
+func (r *CreateRuleRequest) ToService() ownerssrv.Rule {
+	return ownerssrv.Rule{
+		Pattern:   r.Pattern,
+		UserIDs:   r.UserIDs,
+		TeamNames: r.TeamNames,
+	}
+}
+
+func rulesFromService(rules []ownerssrv.Rule) []Rule {
+	result := make([]Rule, len(rules))
+	for i, rule := range rules {
+		result[i].FillFromService(rule)
+	}
+	return result
+}
+
+// rulesFromCodeowners переводит строки CODEOWNERS в правила: @user - пользователь, @org/team - команда
+func rulesFromCodeowners(parsed []codeowners.Rule) ([]ownerssrv.Rule, error) {
+	rules := make([]ownerssrv.Rule, len(parsed))
+	for i, p := range parsed {
+		userIDs, teamNames, err := codeowners.SplitOwners(p.Owners)
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = ownerssrv.Rule{
+			Pattern:   p.Pattern,
+			UserIDs:   userIDs,
+			TeamNames: teamNames,
+		}
+	}
+	return rules, nil
+}
+
+// ResolveRequest пути файлов относительно корня репозитория; если передан codeowners,
+// владельцы определяются по нему, а не по сохраненным правилам
+type ResolveRequest struct {
+	Paths      []string `json:"paths" binding:"required,min=1,dive,required"`
+	Codeowners string   `json:"codeowners" binding:"max=1048576"`
+}
+
+type PathOwners struct {
+	Path      string   `json:"path"`
+	Pattern   string   `json:"pattern,omitempty"`
+	UserIDs   []string `json:"user_ids"`
+	TeamNames []string `json:"team_names"`
+}
+
+type ResolveResponse struct {
+	Paths     []PathOwners `json:"paths"`
+	UserIDs   []string     `json:"user_ids"`
+	TeamNames []string     `json:"team_names"`
+}
+
+func (r *ResolveResponse) FillFromService(s ownerssrv.Resolution) {
+	r.Paths = make([]PathOwners, len(s.Paths))
+	for i, p := range s.Paths {
+		r.Paths[i] = PathOwners{
+			Path:      p.Path,
+			Pattern:   p.Pattern,
+			UserIDs:   p.UserIDs,
+			TeamNames: p.TeamNames,
+		}
+	}
+	r.UserIDs = s.UserIDs
+	r.TeamNames = s.TeamNames
+}
@@ -0,0 +1,17 @@
+package owners
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+type Handler struct {
+	service ServiceOwners
+	logger  *logrus.Logger
+}
+
+func NewHandler(service ServiceOwners, logger *logrus.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
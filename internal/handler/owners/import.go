@@ -0,0 +1,43 @@
+package owners
+
+import (
+	"net/http"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/codeowners"
+	"github.com/gin-gonic/gin"
+)
+
+// maxCodeownersSize ограничение на размер файла CODEOWNERS в теле запроса
+const maxCodeownersSize = 1 << 20
+
+// ImportRules заменяет все правила владения на правила из файла CODEOWNERS в теле запроса
+func (h *Handler) ImportRules(c *gin.Context) {
+	parsed, err := codeowners.Parse(http.MaxBytesReader(c.Writer, c.Request.Body, maxCodeownersSize))
+	if err != nil {
+		api.SendError(c, http.StatusBadRequest, api.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	rules, err := rulesFromCodeowners(parsed)
+	if err != nil {
+		api.SendError(c, http.StatusBadRequest, api.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	saved, err := h.service.ImportRules(c.Request.Context(), rules)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	api.SendOk(c, ImportRulesResponse{
+		Rules: rulesFromService(saved),
+	})
+}
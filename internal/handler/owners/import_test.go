@@ -0,0 +1,101 @@
+package owners
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ImportRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "successful import",
+			body: "# owners\n*  @org/backend\n/docs/  @u1 @org/docs\n/vendor/\n",
+			setupMock: func(m *mockService) {
+				m.On("ImportRules", mock.Anything, []ownerssrv.Rule{
+					{Pattern: "*", TeamNames: []string{"backend"}},
+					{Pattern: "/docs/", UserIDs: []string{"u1"}, TeamNames: []string{"docs"}},
+					{Pattern: "/vendor/"},
+				}).Return([]ownerssrv.Rule{
+					{RuleID: 1, Pattern: "*", TeamNames: []string{"backend"}},
+					{RuleID: 2, Pattern: "/docs/", UserIDs: []string{"u1"}, TeamNames: []string{"docs"}},
+					{RuleID: 3, Pattern: "/vendor/"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ImportRulesResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Len(t, response.Rules, 3)
+				assert.Equal(t, int64(2), response.Rules[1].RuleID)
+				assert.Equal(t, []string{"docs"}, response.Rules[1].TeamNames)
+			},
+		},
+		{
+			name:           "syntax error",
+			body:           "*.js  docs@example.com\n",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "line 1")
+			},
+		},
+		{
+			name: "unknown owner",
+			body: "*  @ghost\n",
+			setupMock: func(m *mockService) {
+				m.On("ImportRules", mock.Anything, []ownerssrv.Rule{{Pattern: "*", UserIDs: []string{"ghost"}}}).
+					Return(nil, fmt.Errorf("%w: users [ghost]", ownerssrv.ErrUnknownOwner))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+			validateBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			req, err := http.NewRequest(http.MethodPost, "/owners/import", strings.NewReader(tt.body))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "text/plain")
+
+			w := httptest.NewRecorder()
+			newTestRouter(mockSvc).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package owners
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/codeowners"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	"github.com/gin-gonic/gin"
+)
+
+// ResolveOwners возвращает владельцев для списка путей, например чтобы CI узнал ревьюверов до создания PR
+func (h *Handler) ResolveOwners(c *gin.Context) {
+	var req ResolveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	var (
+		result ownerssrv.Resolution
+		err    error
+	)
+	if req.Codeowners == "" {
+		result, err = h.service.ResolveOwners(c.Request.Context(), req.Paths)
+	} else {
+		var rules []ownerssrv.Rule
+		rules, err = parseCodeowners(req.Codeowners)
+		if err != nil {
+			api.SendError(c, http.StatusBadRequest, api.Error{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			})
+			return
+		}
+		result, err = h.service.ResolveOwnersWithRules(c.Request.Context(), rules, req.Paths)
+	}
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response := ResolveResponse{}
+	response.FillFromService(result)
+	api.SendOk(c, response)
+}
+
+func parseCodeowners(text string) ([]ownerssrv.Rule, error) {
+	parsed, err := codeowners.Parse(strings.NewReader(text))
+	if err != nil {
+		return nil, err
+	}
+	return rulesFromCodeowners(parsed)
+}
@@ -0,0 +1,151 @@
+package owners
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ResolveOwners(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "stored rules",
+			requestBody: ResolveRequest{Paths: []string{"docs/api.md", "LICENSE"}},
+			setupMock: func(m *mockService) {
+				m.On("ResolveOwners", mock.Anything, []string{"docs/api.md", "LICENSE"}).Return(ownerssrv.Resolution{
+					Paths: []ownerssrv.PathOwners{
+						{Path: "docs/api.md", Pattern: "/docs/", UserIDs: []string{"u2"}, TeamNames: []string{}},
+						{Path: "LICENSE", UserIDs: []string{}, TeamNames: []string{}},
+					},
+					UserIDs:   []string{"u2"},
+					TeamNames: []string{},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ResolveResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Len(t, response.Paths, 2)
+				assert.Equal(t, "/docs/", response.Paths[0].Pattern)
+				assert.Equal(t, []string{"u2"}, response.UserIDs)
+				assert.NotContains(t, w.Body.String(), `"pattern":""`)
+			},
+		},
+		{
+			name: "inline CODEOWNERS",
+			requestBody: ResolveRequest{
+				Paths:      []string{"main.go"},
+				Codeowners: "*  @org/backend\n!vendor/\n",
+			},
+			setupMock: func(m *mockService) {
+				m.On("ResolveOwnersWithRules", mock.Anything, []ownerssrv.Rule{
+					{Pattern: "*", TeamNames: []string{"backend"}},
+					{Pattern: "!vendor/"},
+				}, []string{"main.go"}).Return(ownerssrv.Resolution{
+					Paths: []ownerssrv.PathOwners{
+						{Path: "main.go", Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}},
+					},
+					UserIDs:   []string{},
+					TeamNames: []string{"backend"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ResolveResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, []string{"backend"}, response.TeamNames)
+			},
+		},
+		{
+			name: "inline CODEOWNERS syntax error",
+			requestBody: ResolveRequest{
+				Paths:      []string{"main.go"},
+				Codeowners: "*  @alice\n!docs/  @bob\n",
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "line 2")
+			},
+		},
+		{
+			name:           "empty paths",
+			requestBody:    ResolveRequest{Paths: []string{}},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name: "unknown owner in inline CODEOWNERS",
+			requestBody: ResolveRequest{
+				Paths:      []string{"main.go"},
+				Codeowners: "*  @ghost\n",
+			},
+			setupMock: func(m *mockService) {
+				m.On("ResolveOwnersWithRules", mock.Anything, mock.Anything, []string{"main.go"}).
+					Return(ownerssrv.Resolution{}, fmt.Errorf("%w: users [ghost]", ownerssrv.ErrUnknownOwner))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+		{
+			name:        "internal error",
+			requestBody: ResolveRequest{Paths: []string{"main.go"}},
+			setupMock: func(m *mockService) {
+				m.On("ResolveOwners", mock.Anything, []string{"main.go"}).
+					Return(ownerssrv.Resolution{}, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			body, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/owners/resolve", bytes.NewBuffer(body))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			newTestRouter(mockSvc).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package owners
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListRules(c *gin.Context) {
+	rules, err := h.service.ListRules(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	api.SendOk(c, ListRulesResponse{
+		Rules: rulesFromService(rules),
+	})
+}
+
+func (h *Handler) CreateRule(c *gin.Context) {
+	var req CreateRuleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	rule, err := h.service.CreateRule(c.Request.Context(), req.ToService())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var handlerRule Rule
+	handlerRule.FillFromService(rule)
+
+	api.SendCreated(c, CreateRuleResponse{
+		Rule: handlerRule,
+	})
+}
+
+func (h *Handler) DeleteRule(c *gin.Context, params models.DeleteRuleParams) {
+	if err := h.service.DeleteRule(c.Request.Context(), params.RuleId); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	api.SendOk(c, DeleteRuleResponse{
+		RuleID: params.RuleId,
+	})
+}
@@ -0,0 +1,238 @@
+package owners
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	ownerssrv "github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockService struct {
+	mock.Mock
+}
+
+func (m *mockService) ListRules(ctx context.Context) ([]ownerssrv.Rule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ownerssrv.Rule), args.Error(1)
+}
+
+func (m *mockService) CreateRule(ctx context.Context, rule ownerssrv.Rule) (ownerssrv.Rule, error) {
+	args := m.Called(ctx, rule)
+	return args.Get(0).(ownerssrv.Rule), args.Error(1)
+}
+
+func (m *mockService) ImportRules(ctx context.Context, rules []ownerssrv.Rule) ([]ownerssrv.Rule, error) {
+	args := m.Called(ctx, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ownerssrv.Rule), args.Error(1)
+}
+
+func (m *mockService) DeleteRule(ctx context.Context, ruleID int64) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *mockService) ResolveOwners(ctx context.Context, paths []string) (ownerssrv.Resolution, error) {
+	args := m.Called(ctx, paths)
+	return args.Get(0).(ownerssrv.Resolution), args.Error(1)
+}
+
+func (m *mockService) ResolveOwnersWithRules(ctx context.Context, rules []ownerssrv.Rule, paths []string) (ownerssrv.Resolution, error) {
+	args := m.Called(ctx, rules, paths)
+	return args.Get(0).(ownerssrv.Resolution), args.Error(1)
+}
+
+func newTestRouter(svc *mockService) *gin.Engine {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	handler := &Handler{
+		service: svc,
+		logger:  logger,
+	}
+
+	router := gin.New()
+	router.Use(api.ErrorMiddleware(logger))
+	apitest.RegisterHandlers(router, handler)
+	return router
+}
+
+func TestHandler_ListRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockSvc := new(mockService)
+	mockSvc.On("ListRules", mock.Anything).Return([]ownerssrv.Rule{
+		{RuleID: 1, Pattern: "*", TeamNames: []string{"backend"}},
+	}, nil)
+
+	req, err := http.NewRequest(http.MethodGet, "/owners/rules", nil)
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	newTestRouter(mockSvc).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response ListRulesResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []Rule{{RuleID: 1, Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}}}, response.Rules)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestHandler_CreateRule(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:        "successful create",
+			requestBody: CreateRuleRequest{Pattern: "*.go", UserIDs: []string{"u1"}},
+			setupMock: func(m *mockService) {
+				m.On("CreateRule", mock.Anything, ownerssrv.Rule{Pattern: "*.go", UserIDs: []string{"u1"}}).
+					Return(ownerssrv.Rule{RuleID: 3, Pattern: "*.go", UserIDs: []string{"u1"}}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedError:  "",
+		},
+		{
+			name:           "missing pattern",
+			requestBody:    CreateRuleRequest{UserIDs: []string{"u1"}},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "invalid pattern",
+			requestBody: CreateRuleRequest{Pattern: "!docs/"},
+			setupMock: func(m *mockService) {
+				m.On("CreateRule", mock.Anything, ownerssrv.Rule{Pattern: "!docs/"}).
+					Return(ownerssrv.Rule{}, fmt.Errorf("%w: negation is not supported", ownerssrv.ErrInvalidRule))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "unknown owner",
+			requestBody: CreateRuleRequest{Pattern: "*.go", TeamNames: []string{"nope"}},
+			setupMock: func(m *mockService) {
+				m.On("CreateRule", mock.Anything, ownerssrv.Rule{Pattern: "*.go", TeamNames: []string{"nope"}}).
+					Return(ownerssrv.Rule{}, fmt.Errorf("%w: teams [nope]", ownerssrv.ErrUnknownOwner))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+		{
+			name:        "internal server error",
+			requestBody: CreateRuleRequest{Pattern: "*.go"},
+			setupMock: func(m *mockService) {
+				m.On("CreateRule", mock.Anything, ownerssrv.Rule{Pattern: "*.go"}).
+					Return(ownerssrv.Rule{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/owners/rules", bytes.NewBuffer(bodyBytes))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			newTestRouter(mockSvc).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_DeleteRule(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:        "successful delete",
+			queryParams: "rule_id=3",
+			setupMock: func(m *mockService) {
+				m.On("DeleteRule", mock.Anything, int64(3)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name:           "invalid rule_id",
+			queryParams:    "rule_id=abc",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "rule not found",
+			queryParams: "rule_id=3",
+			setupMock: func(m *mockService) {
+				m.On("DeleteRule", mock.Anything, int64(3)).Return(ownerssrv.ErrRuleNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			req, err := http.NewRequest(http.MethodDelete, "/owners/rules?"+tt.queryParams, nil)
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			newTestRouter(mockSvc).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
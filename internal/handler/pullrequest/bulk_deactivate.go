@@ -1,14 +1,12 @@
 package pullrequest
 
 import (
-	"net/http"
-
 	"github.com/aabbuukkaarr8/PRService/internal/api"
 	"github.com/gin-gonic/gin"
 )
 
 type BulkDeactivateRequest struct {
-	TeamName string `json:"team_name" binding:"required"`
+	TeamName string `json:"team_name" binding:"required,name"`
 }
 
 type ReassignedPR struct {
@@ -26,20 +24,13 @@ func (h *Handler) BulkDeactivateTeamUsers(c *gin.Context) {
 	var req BulkDeactivateRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		api.SendError(c, http.StatusBadRequest, api.Error{
-			Code:    "INVALID_REQUEST",
-			Message: err.Error(),
-		})
+		api.SendBindError(c, err)
 		return
 	}
 
 	result, err := h.service.BulkDeactivateTeamUsers(c.Request.Context(), req.TeamName)
 	if err != nil {
-		h.logger.WithError(err).WithField("team_name", req.TeamName).Error("Failed to bulk deactivate team users")
-		api.SendError(c, http.StatusInternalServerError, api.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
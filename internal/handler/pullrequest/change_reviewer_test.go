@@ -0,0 +1,183 @@
+package pullrequest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ChangeReviewer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "add reviewer",
+			path:        "/pullRequest/addReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-003"},
+			setupMock: func(m *mockService) {
+				m.On("AddReviewer", mock.Anything, "pr-001", "user-003", int64(0)).Return(prsrv.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002", "user-003"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ChangeReviewerResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, []string{"user-002", "user-003"}, response.PR.AssignedReviewers)
+			},
+		},
+		{
+			name:        "add reviewer to full PR",
+			path:        "/pullRequest/addReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-004"},
+			setupMock: func(m *mockService) {
+				m.On("AddReviewer", mock.Anything, "pr-001", "user-004", int64(0)).
+					Return(prsrv.PullRequest{}, fmt.Errorf("%w: PR already has 2 reviewers", prsrv.ErrTooManyReviewers))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "TOO_MANY_REVIEWERS",
+		},
+		{
+			name:        "add already assigned reviewer",
+			path:        "/pullRequest/addReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-002"},
+			setupMock: func(m *mockService) {
+				m.On("AddReviewer", mock.Anything, "pr-001", "user-002", int64(0)).Return(prsrv.PullRequest{}, prsrv.ErrAlreadyAssigned)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "ALREADY_ASSIGNED",
+		},
+		{
+			name:        "add author as reviewer",
+			path:        "/pullRequest/addReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-001"},
+			setupMock: func(m *mockService) {
+				m.On("AddReviewer", mock.Anything, "pr-001", "user-001", int64(0)).
+					Return(prsrv.PullRequest{}, fmt.Errorf("%w: author user-001 cannot review own PR", prsrv.ErrInvalidReviewers))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "add reviewer to merged PR",
+			path:        "/pullRequest/addReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-003"},
+			setupMock: func(m *mockService) {
+				m.On("AddReviewer", mock.Anything, "pr-001", "user-003", int64(0)).Return(prsrv.PullRequest{}, prsrv.ErrPRMerged)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  string(models.PRMERGED),
+		},
+		{
+			name:           "add reviewer without user_id",
+			path:           "/pullRequest/addReviewer",
+			requestBody:    map[string]string{"pull_request_id": "pr-001"},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "remove reviewer",
+			path:        "/pullRequest/removeReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-002"},
+			setupMock: func(m *mockService) {
+				m.On("RemoveReviewer", mock.Anything, "pr-001", "user-002", int64(0)).Return(prsrv.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ChangeReviewerResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Empty(t, response.PR.AssignedReviewers)
+			},
+		},
+		{
+			name:        "remove not assigned reviewer",
+			path:        "/pullRequest/removeReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-009"},
+			setupMock: func(m *mockService) {
+				m.On("RemoveReviewer", mock.Anything, "pr-001", "user-009", int64(0)).Return(prsrv.PullRequest{}, prsrv.ErrNotAssigned)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  string(models.NOTASSIGNED),
+		},
+		{
+			name:        "remove reviewer internal error",
+			path:        "/pullRequest/removeReviewer",
+			requestBody: ChangeReviewerRequest{PullRequestID: "pr-001", UserID: "user-002"},
+			setupMock: func(m *mockService) {
+				m.On("RemoveReviewer", mock.Anything, "pr-001", "user-002", int64(0)).Return(prsrv.PullRequest{}, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, tt.path, bytes.NewBuffer(bodyBytes))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
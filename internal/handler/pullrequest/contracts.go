@@ -8,8 +8,13 @@ import (
 
 type ServicePR interface {
 	CreatePullRequest(ctx context.Context, request prsrv.CreatePullRequest) (prsrv.PullRequest, error)
-	MergePullRequest(ctx context.Context, pullRequestID string) (prsrv.PullRequest, error)
-	ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string) (prsrv.PullRequest, string, error)
+	MergePullRequest(ctx context.Context, pullRequestID string, expectedVersion int64) (prsrv.PullRequest, error)
+	GetPullRequestDetails(ctx context.Context, pullRequestID string) (prsrv.PullRequestDetails, error)
+	ListPullRequests(ctx context.Context, params prsrv.ListPullRequestsParams) (prsrv.PullRequestPage, error)
+	ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string, expectedVersion int64) (prsrv.PullRequest, string, error)
+	ReassignReviewerTo(ctx context.Context, pullRequestID, oldUserID, newUserID string, expectedVersion int64) (prsrv.PullRequest, error)
+	AddReviewer(ctx context.Context, pullRequestID, userID string, expectedVersion int64) (prsrv.PullRequest, error)
+	RemoveReviewer(ctx context.Context, pullRequestID, userID string, expectedVersion int64) (prsrv.PullRequest, error)
 	GetStats(ctx context.Context) (prsrv.Stats, error)
 	BulkDeactivateTeamUsers(ctx context.Context, teamName string) (prsrv.BulkDeactivateResult, error)
 }
@@ -1,63 +1,50 @@
 package pullrequest
 
 import (
-	"errors"
-	"net/http"
-
 	"github.com/aabbuukkaarr8/PRService/internal/api"
-	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	"github.com/gin-gonic/gin"
 )
 
 type CreateRequest struct {
-	AuthorID        string `json:"author_id" binding:"required"`
-	PullRequestID   string `json:"pull_request_id" binding:"required"`
-	PullRequestName string `json:"pull_request_name" binding:"required"`
+	AuthorID           string   `json:"author_id" binding:"required,id"`
+	PullRequestID      string   `json:"pull_request_id" binding:"required,id"`
+	PullRequestName    string   `json:"pull_request_name" binding:"required,name"`
+	TeamName           string   `json:"team_name" binding:"omitempty,name"`
+	Files              []string `json:"files"`
+	Labels             []string `json:"labels"`
+	RequestedReviewers []string `json:"requested_reviewers" binding:"omitempty,dive,required,id"`
+	ExcludedReviewers  []string `json:"excluded_reviewers" binding:"omitempty,dive,required,id"`
 }
 
 func (h *Handler) CreatePullRequest(c *gin.Context) {
 	var req CreateRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		api.SendError(c, http.StatusBadRequest, api.Error{
-			Code:    "INVALID_REQUEST",
-			Message: err.Error(),
-		})
+		api.SendBindError(c, err)
 		return
 	}
 
 	reqToSrv := prsrv.CreatePullRequest{
-		AuthorId:        req.AuthorID,
-		PullRequestId:   req.PullRequestID,
-		PullRequestName: req.PullRequestName,
+		AuthorId:           req.AuthorID,
+		PullRequestId:      req.PullRequestID,
+		PullRequestName:    req.PullRequestName,
+		TeamName:           req.TeamName,
+		Files:              req.Files,
+		Labels:             req.Labels,
+		RequestedReviewers: req.RequestedReviewers,
+		ExcludedReviewers:  req.ExcludedReviewers,
 	}
 
 	resultPR, err := h.service.CreatePullRequest(c.Request.Context(), reqToSrv)
 	if err != nil {
-		switch {
-		case errors.Is(err, prsrv.ErrPRExists):
-			api.SendError(c, http.StatusConflict, api.Error{
-				Code:    models.PREXISTS,
-				Message: "PR id already exists",
-			})
-		case errors.Is(err, prsrv.ErrNotFound):
-			api.SendError(c, http.StatusNotFound, api.Error{
-				Code:    models.NOTFOUND,
-				Message: "author or team not found",
-			})
-		default:
-			h.logger.WithError(err).WithField("pull_request_id", req.PullRequestID).Error("Failed to create PR")
-			api.SendError(c, http.StatusInternalServerError, api.Error{
-				Code:    "INTERNAL_ERROR",
-				Message: err.Error(),
-			})
-		}
+		_ = c.Error(err)
 		return
 	}
 
 	handlerPR := toHandlerPullRequest(resultPR)
 
+	setETag(c, resultPR.Version)
 	api.SendCreated(c, CreatePullRequestResponse{
 		PR: handlerPR,
 	})
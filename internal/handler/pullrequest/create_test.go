@@ -5,11 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	"github.com/gin-gonic/gin"
@@ -30,22 +33,47 @@ func (m *mockService) CreatePullRequest(ctx context.Context, request prsrv.Creat
 	return args.Get(0).(prsrv.PullRequest), args.Error(1)
 }
 
-func (m *mockService) MergePullRequest(ctx context.Context, pullRequestID string) (prsrv.PullRequest, error) {
-	args := m.Called(ctx, pullRequestID)
+func (m *mockService) MergePullRequest(ctx context.Context, pullRequestID string, expectedVersion int64) (prsrv.PullRequest, error) {
+	args := m.Called(ctx, pullRequestID, expectedVersion)
 	if args.Get(0) == nil {
 		return prsrv.PullRequest{}, args.Error(1)
 	}
 	return args.Get(0).(prsrv.PullRequest), args.Error(1)
 }
 
-func (m *mockService) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string) (prsrv.PullRequest, string, error) {
-	args := m.Called(ctx, pullRequestID, oldUserID)
+func (m *mockService) GetPullRequestDetails(ctx context.Context, pullRequestID string) (prsrv.PullRequestDetails, error) {
+	args := m.Called(ctx, pullRequestID)
+	return args.Get(0).(prsrv.PullRequestDetails), args.Error(1)
+}
+
+func (m *mockService) ListPullRequests(ctx context.Context, params prsrv.ListPullRequestsParams) (prsrv.PullRequestPage, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(prsrv.PullRequestPage), args.Error(1)
+}
+
+func (m *mockService) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string, expectedVersion int64) (prsrv.PullRequest, string, error) {
+	args := m.Called(ctx, pullRequestID, oldUserID, expectedVersion)
 	if args.Get(0) == nil {
 		return prsrv.PullRequest{}, "", args.Error(2)
 	}
 	return args.Get(0).(prsrv.PullRequest), args.String(1), args.Error(2)
 }
 
+func (m *mockService) ReassignReviewerTo(ctx context.Context, pullRequestID, oldUserID, newUserID string, expectedVersion int64) (prsrv.PullRequest, error) {
+	args := m.Called(ctx, pullRequestID, oldUserID, newUserID, expectedVersion)
+	return args.Get(0).(prsrv.PullRequest), args.Error(1)
+}
+
+func (m *mockService) AddReviewer(ctx context.Context, pullRequestID, userID string, expectedVersion int64) (prsrv.PullRequest, error) {
+	args := m.Called(ctx, pullRequestID, userID, expectedVersion)
+	return args.Get(0).(prsrv.PullRequest), args.Error(1)
+}
+
+func (m *mockService) RemoveReviewer(ctx context.Context, pullRequestID, userID string, expectedVersion int64) (prsrv.PullRequest, error) {
+	args := m.Called(ctx, pullRequestID, userID, expectedVersion)
+	return args.Get(0).(prsrv.PullRequest), args.Error(1)
+}
+
 func (m *mockService) GetStats(ctx context.Context) (prsrv.Stats, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -174,7 +202,7 @@ func TestHandler_CreatePullRequest(t *testing.T) {
 					PullRequestId:   "pr-004",
 					PullRequestName: "Test PR 4",
 					AuthorId:        "user-999",
-				}).Return(prsrv.PullRequest{}, prsrv.ErrNotFound)
+				}).Return(prsrv.PullRequest{}, fmt.Errorf("%w: author user-999", prsrv.ErrNotFound))
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedError:  string(models.NOTFOUND),
@@ -183,7 +211,175 @@ func TestHandler_CreatePullRequest(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), string(models.NOTFOUND))
-				assert.Contains(t, w.Body.String(), "author or team not found")
+				assert.Contains(t, w.Body.String(), "not found: author user-999")
+			},
+		},
+		{
+			name: "team required for author in several teams",
+			requestBody: CreateRequest{
+				PullRequestID:   "pr-006",
+				PullRequestName: "Test PR 6",
+				AuthorID:        "user-001",
+			},
+			setupMock: func(m *mockService) {
+				m.On("CreatePullRequest", mock.Anything, prsrv.CreatePullRequest{
+					PullRequestId:   "pr-006",
+					PullRequestName: "Test PR 6",
+					AuthorId:        "user-001",
+				}).Return(prsrv.PullRequest{}, prsrv.ErrTeamRequired)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "team_name is required")
+			},
+		},
+		{
+			name: "explicit team is passed to service",
+			requestBody: CreateRequest{
+				PullRequestID:   "pr-007",
+				PullRequestName: "Test PR 7",
+				AuthorID:        "user-001",
+				TeamName:        "devops",
+			},
+			setupMock: func(m *mockService) {
+				m.On("CreatePullRequest", mock.Anything, prsrv.CreatePullRequest{
+					PullRequestId:   "pr-007",
+					PullRequestName: "Test PR 7",
+					AuthorId:        "user-001",
+					TeamName:        "devops",
+				}).Return(prsrv.PullRequest{
+					PullRequestID:     "pr-007",
+					PullRequestName:   "Test PR 7",
+					AuthorID:          "user-001",
+					TeamName:          "devops",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-005"},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response CreatePullRequestResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "devops", response.PR.TeamName)
+			},
+		},
+		{
+			name: "files and labels are passed to service",
+			requestBody: CreateRequest{
+				PullRequestID:   "pr-008",
+				PullRequestName: "Test PR 8",
+				AuthorID:        "user-001",
+				Files:           []string{"docs/readme.md"},
+				Labels:          []string{"docs"},
+			},
+			setupMock: func(m *mockService) {
+				m.On("CreatePullRequest", mock.Anything, prsrv.CreatePullRequest{
+					PullRequestId:   "pr-008",
+					PullRequestName: "Test PR 8",
+					AuthorId:        "user-001",
+					Files:           []string{"docs/readme.md"},
+					Labels:          []string{"docs"},
+				}).Return(prsrv.PullRequest{
+					PullRequestID:     "pr-008",
+					PullRequestName:   "Test PR 8",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-009"},
+					Files:             []string{"docs/readme.md"},
+					Labels:            []string{"docs"},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response CreatePullRequestResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"docs/readme.md"}, response.PR.Files)
+				assert.Equal(t, []string{"docs"}, response.PR.Labels)
+			},
+		},
+		{
+			name: "requested and excluded reviewers are passed to service",
+			requestBody: CreateRequest{
+				PullRequestID:      "pr-009",
+				PullRequestName:    "Test PR 9",
+				AuthorID:           "user-001",
+				RequestedReviewers: []string{"user-004"},
+				ExcludedReviewers:  []string{"user-002"},
+			},
+			setupMock: func(m *mockService) {
+				m.On("CreatePullRequest", mock.Anything, prsrv.CreatePullRequest{
+					PullRequestId:      "pr-009",
+					PullRequestName:    "Test PR 9",
+					AuthorId:           "user-001",
+					RequestedReviewers: []string{"user-004"},
+					ExcludedReviewers:  []string{"user-002"},
+				}).Return(prsrv.PullRequest{
+					PullRequestID:     "pr-009",
+					PullRequestName:   "Test PR 9",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-004", "user-003"},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response CreatePullRequestResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"user-004", "user-003"}, response.PR.AssignedReviewers)
+			},
+		},
+		{
+			name: "invalid requested reviewers",
+			requestBody: CreateRequest{
+				PullRequestID:      "pr-010",
+				PullRequestName:    "Test PR 10",
+				AuthorID:           "user-001",
+				RequestedReviewers: []string{"user-001"},
+			},
+			setupMock: func(m *mockService) {
+				m.On("CreatePullRequest", mock.Anything, mock.Anything).Return(prsrv.PullRequest{},
+					fmt.Errorf("%w: author user-001 cannot be requested as a reviewer", prsrv.ErrInvalidReviewers))
+			},
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
+				assert.Contains(t, w.Body.String(), "cannot be requested")
+			},
+		},
+		{
+			name: "requested reviewer not found",
+			requestBody: CreateRequest{
+				PullRequestID:      "pr-011",
+				PullRequestName:    "Test PR 11",
+				AuthorID:           "user-001",
+				RequestedReviewers: []string{"ghost"},
+			},
+			setupMock: func(m *mockService) {
+				m.On("CreatePullRequest", mock.Anything, mock.Anything).Return(prsrv.PullRequest{},
+					fmt.Errorf("%w: [ghost]", prsrv.ErrReviewerNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), string(models.NOTFOUND))
+				assert.Contains(t, w.Body.String(), "ghost")
+			},
+		},
+		{
+			name: "empty requested reviewer id",
+			requestBody: map[string]interface{}{
+				"pull_request_id":     "pr-012",
+				"pull_request_name":   "Test PR 12",
+				"author_id":           "user-001",
+				"requested_reviewers": []string{""},
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
 			},
 		},
 		{
@@ -207,7 +403,8 @@ func TestHandler_CreatePullRequest(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 		{
@@ -272,7 +469,8 @@ func TestHandler_CreatePullRequest(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.POST("/pullRequest/create", handler.CreatePullRequest)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			var bodyBytes []byte
 			var err error
@@ -10,10 +10,14 @@ type PullRequest struct {
 	PullRequestID     string     `json:"pull_request_id" binding:"required"`
 	PullRequestName   string     `json:"pull_request_name" binding:"required"`
 	AuthorID          string     `json:"author_id" binding:"required"`
+	TeamName          string     `json:"team_name,omitempty"`
 	Status            string     `json:"status" binding:"required,oneof=OPEN MERGED"`
 	AssignedReviewers []string   `json:"assigned_reviewers" binding:"max=2,dive,required"`
+	Files             []string   `json:"files,omitempty"`
+	Labels            []string   `json:"labels,omitempty"`
 	CreatedAt         *time.Time `json:"created_at,omitempty"`
 	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	Version           int64      `json:"version"`
 }
 
 type CreatePullRequestResponse struct {
@@ -21,16 +25,18 @@ type CreatePullRequestResponse struct {
 }
 
 type MergeRequest struct {
-	PullRequestID string `json:"pull_request_id" binding:"required"`
+	PullRequestID string `json:"pull_request_id" binding:"required,id"`
 }
 
 type MergePullRequestResponse struct {
 	PR PullRequest `json:"pr"`
 }
 
+// ReassignReviewerRequest если new_reviewer_id не указан, замена выбирается случайно из команды
 type ReassignReviewerRequest struct {
-	PullRequestID string `json:"pull_request_id" binding:"required"`
-	OldUserID     string `json:"old_reviewer_id" binding:"required"`
+	PullRequestID string `json:"pull_request_id" binding:"required,id"`
+	OldUserID     string `json:"old_reviewer_id" binding:"required,id"`
+	NewUserID     string `json:"new_reviewer_id" binding:"omitempty,id"`
 }
 
 type ReassignReviewerResponse struct {
@@ -38,14 +44,69 @@ type ReassignReviewerResponse struct {
 	ReplacedBy string      `json:"replaced_by"`
 }
 
+type ChangeReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id" binding:"required,id"`
+	UserID        string `json:"user_id" binding:"required,id"`
+}
+
+type ChangeReviewerResponse struct {
+	PR PullRequest `json:"pr"`
+}
+
 func toHandlerPullRequest(s prsrv.PullRequest) PullRequest {
 	return PullRequest{
 		PullRequestID:     s.PullRequestID,
 		PullRequestName:   s.PullRequestName,
 		AuthorID:          s.AuthorID,
+		TeamName:          s.TeamName,
 		Status:            s.Status,
 		AssignedReviewers: s.AssignedReviewers,
+		Files:             s.Files,
+		Labels:            s.Labels,
 		CreatedAt:         s.CreatedAt,
 		MergedAt:          s.MergedAt,
+		Version:           s.Version,
+	}
+}
+
+type Reviewer struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// PullRequestDetails PR с именами автора и ревьюверов
+type PullRequestDetails struct {
+	PullRequest
+	AuthorUsername string     `json:"author_username"`
+	Reviewers      []Reviewer `json:"reviewers"`
+}
+
+type GetPullRequestResponse struct {
+	PR PullRequestDetails `json:"pr"`
+}
+
+type ListPullRequestsResponse struct {
+	PullRequests []PullRequestDetails `json:"pull_requests"`
+	NextCursor   string               `json:"next_cursor,omitempty"`
+	Limit        int                  `json:"limit"`
+}
+
+func (d *PullRequestDetails) FillFromService(s prsrv.PullRequestDetails) {
+	d.PullRequest = toHandlerPullRequest(s.PullRequest)
+	d.AuthorUsername = s.AuthorUsername
+	d.Reviewers = make([]Reviewer, len(s.Reviewers))
+	for i, r := range s.Reviewers {
+		d.Reviewers[i] = Reviewer{UserID: r.UserID, Username: r.Username}
 	}
 }
+
+func (r *ListPullRequestsResponse) FillFromService(s prsrv.PullRequestPage) {
+	prs := make([]PullRequestDetails, len(s.PullRequests))
+	for i, pr := range s.PullRequests {
+		prs[i].FillFromService(pr)
+	}
+
+	r.PullRequests = prs
+	r.NextCursor = s.NextCursor
+	r.Limit = s.Limit
+}
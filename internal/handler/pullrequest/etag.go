@@ -0,0 +1,36 @@
+package pullrequest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/gin-gonic/gin"
+)
+
+// setETag отдает версию PR в заголовке ETag; клиент передает ее обратно в If-Match
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion разбирает заголовок If-Match: "3", 3 или W/"3". Без заголовка и для * возвращает 0 -
+// изменение применяется к текущей версии PR. При некорректном значении отправляет 400 и возвращает false
+func ifMatchVersion(c *gin.Context, ifMatch *string) (int64, bool) {
+	value := strings.TrimSpace(api.Deref(ifMatch))
+	if value == "" || value == "*" {
+		return 0, true
+	}
+
+	value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		api.SendError(c, http.StatusBadRequest, api.Error{
+			Code:    "INVALID_REQUEST",
+			Message: "If-Match must contain a single PR version from ETag",
+		})
+		return 0, false
+	}
+
+	return version, true
+}
@@ -0,0 +1,23 @@
+package pullrequest
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) GetPullRequest(c *gin.Context, params models.GetPullRequestParams) {
+	resultPR, err := h.service.GetPullRequestDetails(c.Request.Context(), params.PullRequestId)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var handlerPR PullRequestDetails
+	handlerPR.FillFromService(resultPR)
+
+	setETag(c, resultPR.Version)
+	api.SendOk(c, GetPullRequestResponse{
+		PR: handlerPR,
+	})
+}
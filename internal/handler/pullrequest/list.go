@@ -0,0 +1,32 @@
+package pullrequest
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListPullRequests(c *gin.Context, params models.ListPullRequestsParams) {
+	result, err := h.service.ListPullRequests(c.Request.Context(), prsrv.ListPullRequestsParams{
+		AuthorID:    api.Deref(params.AuthorId),
+		ReviewerID:  api.Deref(params.ReviewerId),
+		TeamName:    api.Deref(params.TeamName),
+		Status:      string(api.Deref(params.Status)),
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		Sort:        string(api.Deref(params.Sort)),
+		Order:       string(api.Deref(params.Order)),
+		Limit:       api.Deref(params.Limit),
+		Cursor:      api.Deref(params.Cursor),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response ListPullRequestsResponse
+	response.FillFromService(result)
+
+	api.SendOk(c, response)
+}
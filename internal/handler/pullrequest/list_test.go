@@ -0,0 +1,193 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_GetAndListPullRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	details := prsrv.PullRequestDetails{
+		PullRequest: prsrv.PullRequest{
+			PullRequestID:     "pr-001",
+			PullRequestName:   "Test PR",
+			AuthorID:          "user-001",
+			Status:            "OPEN",
+			AssignedReviewers: []string{"user-002"},
+		},
+		AuthorUsername: "alice",
+		Reviewers:      []prsrv.Reviewer{{UserID: "user-002", Username: "bob"}},
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "get PR",
+			path: "/pullRequest/get?pull_request_id=pr-001",
+			setupMock: func(m *mockService) {
+				m.On("GetPullRequestDetails", mock.Anything, "pr-001").Return(details, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response GetPullRequestResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, "pr-001", response.PR.PullRequestID)
+				assert.Equal(t, "alice", response.PR.AuthorUsername)
+				assert.Equal(t, []Reviewer{{UserID: "user-002", Username: "bob"}}, response.PR.Reviewers)
+			},
+		},
+		{
+			name:           "get PR without id",
+			path:           "/pullRequest/get",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name: "get unknown PR",
+			path: "/pullRequest/get?pull_request_id=pr-999",
+			setupMock: func(m *mockService) {
+				m.On("GetPullRequestDetails", mock.Anything, "pr-999").Return(prsrv.PullRequestDetails{}, prsrv.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+		{
+			name: "list with filters",
+			path: "/pullRequest/list?author_id=user-001&reviewer_id=user-002&team_name=backend&status=OPEN" +
+				"&created_from=2025-01-01T00:00:00Z&sort=pull_request_id&order=asc&limit=10&cursor=abc",
+			setupMock: func(m *mockService) {
+				m.On("ListPullRequests", mock.Anything, prsrv.ListPullRequestsParams{
+					AuthorID:    "user-001",
+					ReviewerID:  "user-002",
+					TeamName:    "backend",
+					Status:      "OPEN",
+					CreatedFrom: &createdFrom,
+					Sort:        "pull_request_id",
+					Order:       "asc",
+					Limit:       10,
+					Cursor:      "abc",
+				}).Return(prsrv.PullRequestPage{
+					PullRequests: []prsrv.PullRequestDetails{details},
+					NextCursor:   "next",
+					Limit:        10,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ListPullRequestsResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Len(t, response.PullRequests, 1)
+				assert.Equal(t, "bob", response.PullRequests[0].Reviewers[0].Username)
+				assert.Equal(t, "next", response.NextCursor)
+				assert.Equal(t, 10, response.Limit)
+			},
+		},
+		{
+			name: "empty list",
+			path: "/pullRequest/list",
+			setupMock: func(m *mockService) {
+				m.On("ListPullRequests", mock.Anything, prsrv.ListPullRequestsParams{}).Return(prsrv.PullRequestPage{
+					PullRequests: []prsrv.PullRequestDetails{},
+					Limit:        50,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.JSONEq(t, `{"pull_requests":[],"limit":50}`, w.Body.String())
+			},
+		},
+		{
+			name:           "invalid created_to",
+			path:           "/pullRequest/list?created_to=yesterday",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid format for parameter created_to",
+		},
+		{
+			name:           "invalid limit",
+			path:           "/pullRequest/list?limit=ten",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid format for parameter limit",
+		},
+		{
+			name: "invalid cursor",
+			path: "/pullRequest/list?cursor=broken",
+			setupMock: func(m *mockService) {
+				m.On("ListPullRequests", mock.Anything, prsrv.ListPullRequestsParams{Cursor: "broken"}).
+					Return(prsrv.PullRequestPage{}, fmt.Errorf("%w: malformed cursor", prsrv.ErrInvalidCursor))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "invalid cursor: malformed cursor",
+		},
+		{
+			name: "list internal error",
+			path: "/pullRequest/list",
+			setupMock: func(m *mockService) {
+				m.On("ListPullRequests", mock.Anything, prsrv.ListPullRequestsParams{}).
+					Return(prsrv.PullRequestPage{}, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			req, err := http.NewRequest(http.MethodGet, tt.path, nil)
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
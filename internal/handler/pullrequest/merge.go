@@ -1,46 +1,33 @@
 package pullrequest
 
 import (
-	"errors"
-	"net/http"
-
 	"github.com/aabbuukkaarr8/PRService/internal/api"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
-	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	"github.com/gin-gonic/gin"
 )
 
-func (h *Handler) MergePullRequest(c *gin.Context) {
+func (h *Handler) MergePullRequest(c *gin.Context, params models.MergePullRequestParams) {
 	var req MergeRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		api.SendError(c, http.StatusBadRequest, api.Error{
-			Code:    "INVALID_REQUEST",
-			Message: err.Error(),
-		})
+		api.SendBindError(c, err)
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c, params.IfMatch)
+	if !ok {
 		return
 	}
 
-	resultPR, err := h.service.MergePullRequest(c.Request.Context(), req.PullRequestID)
+	resultPR, err := h.service.MergePullRequest(c.Request.Context(), req.PullRequestID, expectedVersion)
 	if err != nil {
-		switch {
-		case errors.Is(err, prsrv.ErrNotFound):
-			api.SendError(c, http.StatusNotFound, api.Error{
-				Code:    models.NOTFOUND,
-				Message: "PR not found",
-			})
-		default:
-			h.logger.WithError(err).WithField("pull_request_id", req.PullRequestID).Error("Failed to merge PR")
-			api.SendError(c, http.StatusInternalServerError, api.Error{
-				Code:    "INTERNAL_ERROR",
-				Message: err.Error(),
-			})
-		}
+		_ = c.Error(err)
 		return
 	}
 
 	handlerPR := toHandlerPullRequest(resultPR)
 
+	setETag(c, resultPR.Version)
 	api.SendOk(c, MergePullRequestResponse{
 		PR: handlerPR,
 	})
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	"github.com/gin-gonic/gin"
@@ -23,6 +26,7 @@ func TestHandler_MergePullRequest(t *testing.T) {
 	tests := []struct {
 		name           string
 		requestBody    interface{}
+		ifMatch        string
 		setupMock      func(*mockService)
 		expectedStatus int
 		expectedError  string
@@ -35,7 +39,7 @@ func TestHandler_MergePullRequest(t *testing.T) {
 			},
 			setupMock: func(m *mockService) {
 				now := time.Now()
-				m.On("MergePullRequest", mock.Anything, "pr-001").Return(prsrv.PullRequest{
+				m.On("MergePullRequest", mock.Anything, "pr-001", int64(0)).Return(prsrv.PullRequest{
 					PullRequestID:     "pr-001",
 					PullRequestName:   "Test PR",
 					AuthorID:          "user-001",
@@ -91,7 +95,7 @@ func TestHandler_MergePullRequest(t *testing.T) {
 				PullRequestID: "pr-999",
 			},
 			setupMock: func(m *mockService) {
-				m.On("MergePullRequest", mock.Anything, "pr-999").Return(prsrv.PullRequest{}, prsrv.ErrNotFound)
+				m.On("MergePullRequest", mock.Anything, "pr-999", int64(0)).Return(prsrv.PullRequest{}, fmt.Errorf("%w: PR pr-999", prsrv.ErrNotFound))
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedError:  string(models.NOTFOUND),
@@ -100,7 +104,7 @@ func TestHandler_MergePullRequest(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), string(models.NOTFOUND))
-				assert.Contains(t, w.Body.String(), "PR not found")
+				assert.Contains(t, w.Body.String(), "not found: PR pr-999")
 			},
 		},
 		{
@@ -109,7 +113,7 @@ func TestHandler_MergePullRequest(t *testing.T) {
 				PullRequestID: "pr-002",
 			},
 			setupMock: func(m *mockService) {
-				m.On("MergePullRequest", mock.Anything, "pr-002").Return(prsrv.PullRequest{}, errors.New("database connection failed"))
+				m.On("MergePullRequest", mock.Anything, "pr-002", int64(0)).Return(prsrv.PullRequest{}, errors.New("database connection failed"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "INTERNAL_ERROR",
@@ -118,7 +122,8 @@ func TestHandler_MergePullRequest(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 		{
@@ -156,7 +161,7 @@ func TestHandler_MergePullRequest(t *testing.T) {
 			},
 			setupMock: func(m *mockService) {
 				now := time.Now()
-				m.On("MergePullRequest", mock.Anything, "pr-003").Return(prsrv.PullRequest{
+				m.On("MergePullRequest", mock.Anything, "pr-003", int64(0)).Return(prsrv.PullRequest{
 					PullRequestID:     "pr-003",
 					PullRequestName:   "Test PR 3",
 					AuthorID:          "user-001",
@@ -177,6 +182,52 @@ func TestHandler_MergePullRequest(t *testing.T) {
 				assert.NotNil(t, response.PR.MergedAt)
 			},
 		},
+		{
+			name:        "merge with matching If-Match returns new ETag",
+			requestBody: MergeRequest{PullRequestID: "pr-001"},
+			ifMatch:     `"3"`,
+			setupMock: func(m *mockService) {
+				m.On("MergePullRequest", mock.Anything, "pr-001", int64(3)).Return(prsrv.PullRequest{
+					PullRequestID: "pr-001",
+					Status:        "MERGED",
+					Version:       4,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+				var response MergePullRequestResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, int64(4), response.PR.Version)
+			},
+		},
+		{
+			name:        "stale If-Match",
+			requestBody: MergeRequest{PullRequestID: "pr-001"},
+			ifMatch:     `"2"`,
+			setupMock: func(m *mockService) {
+				m.On("MergePullRequest", mock.Anything, "pr-001", int64(2)).Return(prsrv.PullRequest{}, prsrv.ErrVersionMismatch)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedError:  "VERSION_MISMATCH",
+		},
+		{
+			name:        "concurrent updates",
+			requestBody: MergeRequest{PullRequestID: "pr-001"},
+			setupMock: func(m *mockService) {
+				m.On("MergePullRequest", mock.Anything, "pr-001", int64(0)).Return(prsrv.PullRequest{}, prsrv.ErrConcurrentUpdate)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "CONCURRENT_UPDATE",
+		},
+		{
+			name:           "invalid If-Match",
+			requestBody:    MergeRequest{PullRequestID: "pr-001"},
+			ifMatch:        `"abc"`,
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
 	}
 
 	for _, tt := range tests {
@@ -192,7 +243,8 @@ func TestHandler_MergePullRequest(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.POST("/pullRequest/merge", handler.MergePullRequest)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			var bodyBytes []byte
 			var err error
@@ -207,6 +259,9 @@ func TestHandler_MergePullRequest(t *testing.T) {
 			req, err := http.NewRequest(http.MethodPost, "/pullRequest/merge", bytes.NewBuffer(bodyBytes))
 			assert.NoError(t, err)
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	prsrv "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	"github.com/gin-gonic/gin"
@@ -28,6 +31,61 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 		expectedError  string
 		validateBody   func(*testing.T, *httptest.ResponseRecorder)
 	}{
+		{
+			name: "reassignment to explicit reviewer",
+			requestBody: ReassignReviewerRequest{
+				PullRequestID: "pr-001",
+				OldUserID:     "user-002",
+				NewUserID:     "user-009",
+			},
+			setupMock: func(m *mockService) {
+				m.On("ReassignReviewerTo", mock.Anything, "pr-001", "user-002", "user-009", int64(0)).Return(prsrv.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-009", "user-003"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ReassignReviewerResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "user-009", response.ReplacedBy)
+				assert.Equal(t, []string{"user-009", "user-003"}, response.PR.AssignedReviewers)
+			},
+		},
+		{
+			name: "explicit reviewer already assigned",
+			requestBody: ReassignReviewerRequest{
+				PullRequestID: "pr-001",
+				OldUserID:     "user-002",
+				NewUserID:     "user-003",
+			},
+			setupMock: func(m *mockService) {
+				m.On("ReassignReviewerTo", mock.Anything, "pr-001", "user-002", "user-003", int64(0)).
+					Return(prsrv.PullRequest{}, prsrv.ErrAlreadyAssigned)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "ALREADY_ASSIGNED",
+			validateBody:   nil,
+		},
+		{
+			name: "explicit reviewer is inactive",
+			requestBody: ReassignReviewerRequest{
+				PullRequestID: "pr-001",
+				OldUserID:     "user-002",
+				NewUserID:     "user-009",
+			},
+			setupMock: func(m *mockService) {
+				m.On("ReassignReviewerTo", mock.Anything, "pr-001", "user-002", "user-009", int64(0)).
+					Return(prsrv.PullRequest{}, fmt.Errorf("%w: reviewer user-009 is inactive", prsrv.ErrInvalidReviewers))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "is inactive",
+			validateBody:   nil,
+		},
 		{
 			name: "successful reassignment",
 			requestBody: ReassignReviewerRequest{
@@ -36,7 +94,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 			},
 			setupMock: func(m *mockService) {
 				now := time.Now()
-				m.On("ReassignReviewer", mock.Anything, "pr-001", "user-002").Return(prsrv.PullRequest{
+				m.On("ReassignReviewer", mock.Anything, "pr-001", "user-002", int64(0)).Return(prsrv.PullRequest{
 					PullRequestID:     "pr-001",
 					PullRequestName:   "Test PR",
 					AuthorID:          "user-001",
@@ -92,7 +150,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				OldUserID:     "user-999",
 			},
 			setupMock: func(m *mockService) {
-				m.On("ReassignReviewer", mock.Anything, "pr-999", "user-999").Return(prsrv.PullRequest{}, "", prsrv.ErrNotFound)
+				m.On("ReassignReviewer", mock.Anything, "pr-999", "user-999", int64(0)).Return(prsrv.PullRequest{}, "", fmt.Errorf("%w: user user-999", prsrv.ErrNotFound))
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedError:  string(models.NOTFOUND),
@@ -101,7 +159,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), string(models.NOTFOUND))
-				assert.Contains(t, w.Body.String(), "PR or user not found")
+				assert.Contains(t, w.Body.String(), "not found: user user-999")
 			},
 		},
 		{
@@ -111,7 +169,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				OldUserID:     "user-002",
 			},
 			setupMock: func(m *mockService) {
-				m.On("ReassignReviewer", mock.Anything, "pr-003", "user-002").Return(prsrv.PullRequest{}, "", prsrv.ErrPRMerged)
+				m.On("ReassignReviewer", mock.Anything, "pr-003", "user-002", int64(0)).Return(prsrv.PullRequest{}, "", prsrv.ErrPRMerged)
 			},
 			expectedStatus: http.StatusConflict,
 			expectedError:  string(models.PRMERGED),
@@ -120,7 +178,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), string(models.PRMERGED))
-				assert.Contains(t, w.Body.String(), "cannot reassign on merged PR")
+				assert.Contains(t, w.Body.String(), "cannot change reviewers on merged PR")
 			},
 		},
 		{
@@ -130,7 +188,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				OldUserID:     "user-999",
 			},
 			setupMock: func(m *mockService) {
-				m.On("ReassignReviewer", mock.Anything, "pr-004", "user-999").Return(prsrv.PullRequest{}, "", prsrv.ErrNotAssigned)
+				m.On("ReassignReviewer", mock.Anything, "pr-004", "user-999", int64(0)).Return(prsrv.PullRequest{}, "", prsrv.ErrNotAssigned)
 			},
 			expectedStatus: http.StatusConflict,
 			expectedError:  string(models.NOTASSIGNED),
@@ -149,7 +207,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				OldUserID:     "user-002",
 			},
 			setupMock: func(m *mockService) {
-				m.On("ReassignReviewer", mock.Anything, "pr-005", "user-002").Return(prsrv.PullRequest{}, "", prsrv.ErrNoCandidate)
+				m.On("ReassignReviewer", mock.Anything, "pr-005", "user-002", int64(0)).Return(prsrv.PullRequest{}, "", prsrv.ErrNoCandidate)
 			},
 			expectedStatus: http.StatusConflict,
 			expectedError:  string(models.NOCANDIDATE),
@@ -168,7 +226,7 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				OldUserID:     "user-002",
 			},
 			setupMock: func(m *mockService) {
-				m.On("ReassignReviewer", mock.Anything, "pr-006", "user-002").Return(prsrv.PullRequest{}, "", errors.New("database connection failed"))
+				m.On("ReassignReviewer", mock.Anything, "pr-006", "user-002", int64(0)).Return(prsrv.PullRequest{}, "", errors.New("database connection failed"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "INTERNAL_ERROR",
@@ -177,7 +235,8 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 		{
@@ -240,7 +299,8 @@ func TestHandler_ReassignReviewer(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.POST("/pullRequest/reassign", handler.ReassignReviewer)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			var bodyBytes []byte
 			var err error
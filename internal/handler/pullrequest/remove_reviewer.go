@@ -0,0 +1,32 @@
+package pullrequest
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) RemoveReviewer(c *gin.Context, params models.RemoveReviewerParams) {
+	var req ChangeReviewerRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	expectedVersion, ok := ifMatchVersion(c, params.IfMatch)
+	if !ok {
+		return
+	}
+
+	resultPR, err := h.service.RemoveReviewer(c.Request.Context(), req.PullRequestID, req.UserID, expectedVersion)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	setETag(c, resultPR.Version)
+	api.SendOk(c, ChangeReviewerResponse{
+		PR: toHandlerPullRequest(resultPR),
+	})
+}
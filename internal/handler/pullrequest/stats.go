@@ -1,8 +1,6 @@
 package pullrequest
 
 import (
-	"net/http"
-
 	"github.com/aabbuukkaarr8/PRService/internal/api"
 	"github.com/gin-gonic/gin"
 )
@@ -28,11 +26,7 @@ type StatsResponse struct {
 func (h *Handler) GetStats(c *gin.Context) {
 	stats, err := h.service.GetStats(c.Request.Context())
 	if err != nil {
-		h.logger.WithError(err).Error("Failed to get statistics")
-		api.SendError(c, http.StatusInternalServerError, api.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: err.Error(),
-		})
+		_ = c.Error(err)
 		return
 	}
 
@@ -9,4 +9,10 @@ import (
 type ServiceTeam interface {
 	CreateTeam(ctx context.Context, team teamsrv.Team) (teamsrv.Team, error)
 	GetTeam(ctx context.Context, teamName string) (teamsrv.Team, error)
+	ListTeams(ctx context.Context, params teamsrv.ListTeamsParams) (teamsrv.TeamList, error)
+	ImportTeams(ctx context.Context, teams []teamsrv.Team, dryRun bool) (teamsrv.ImportResult, error)
+	AddMembers(ctx context.Context, teamName string, members []teamsrv.TeamMember) (teamsrv.TeamUpdateResult, error)
+	RemoveMembers(ctx context.Context, teamName string, userIDs []string) (teamsrv.TeamUpdateResult, error)
+	RenameTeam(ctx context.Context, teamName, newTeamName string) (teamsrv.Team, error)
+	DeleteTeam(ctx context.Context, teamName string) (teamsrv.DeleteTeamResult, error)
 }
@@ -1,12 +1,7 @@
 package team
 
 import (
-	"errors"
-	"net/http"
-
 	"github.com/aabbuukkaarr8/PRService/internal/api"
-	"github.com/aabbuukkaarr8/PRService/internal/api/models"
-	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
 	"github.com/gin-gonic/gin"
 )
 
@@ -14,10 +9,7 @@ func (h *Handler) CreateTeam(c *gin.Context) {
 	var req CreateTeamRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		api.SendError(c, http.StatusBadRequest, api.Error{
-			Code:    "INVALID_REQUEST",
-			Message: err.Error(),
-		})
+		api.SendBindError(c, err)
 		return
 	}
 
@@ -25,19 +17,7 @@ func (h *Handler) CreateTeam(c *gin.Context) {
 
 	resultTeam, err := h.service.CreateTeam(c.Request.Context(), serviceTeam)
 	if err != nil {
-		switch {
-		case errors.Is(err, teamsrv.ErrTeamExists):
-			api.SendError(c, http.StatusBadRequest, api.Error{
-				Code:    models.TEAMEXISTS,
-				Message: "team_name already exists",
-			})
-		default:
-			h.logger.WithError(err).WithField("team_name", req.TeamName).Error("Failed to create team")
-			api.SendError(c, http.StatusInternalServerError, api.Error{
-				Code:    "INTERNAL_ERROR",
-				Message: err.Error(),
-			})
-		}
+		_ = c.Error(err)
 		return
 	}
 
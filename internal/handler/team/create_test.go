@@ -9,8 +9,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/aabbuukkaarr8/PRService/internal/validation"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +40,36 @@ func (m *mockService) GetTeam(ctx context.Context, teamName string) (teamsrv.Tea
 	return args.Get(0).(teamsrv.Team), args.Error(1)
 }
 
+func (m *mockService) ListTeams(ctx context.Context, params teamsrv.ListTeamsParams) (teamsrv.TeamList, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(teamsrv.TeamList), args.Error(1)
+}
+
+func (m *mockService) ImportTeams(ctx context.Context, teams []teamsrv.Team, dryRun bool) (teamsrv.ImportResult, error) {
+	args := m.Called(ctx, teams, dryRun)
+	return args.Get(0).(teamsrv.ImportResult), args.Error(1)
+}
+
+func (m *mockService) AddMembers(ctx context.Context, teamName string, members []teamsrv.TeamMember) (teamsrv.TeamUpdateResult, error) {
+	args := m.Called(ctx, teamName, members)
+	return args.Get(0).(teamsrv.TeamUpdateResult), args.Error(1)
+}
+
+func (m *mockService) RemoveMembers(ctx context.Context, teamName string, userIDs []string) (teamsrv.TeamUpdateResult, error) {
+	args := m.Called(ctx, teamName, userIDs)
+	return args.Get(0).(teamsrv.TeamUpdateResult), args.Error(1)
+}
+
+func (m *mockService) RenameTeam(ctx context.Context, teamName, newTeamName string) (teamsrv.Team, error) {
+	args := m.Called(ctx, teamName, newTeamName)
+	return args.Get(0).(teamsrv.Team), args.Error(1)
+}
+
+func (m *mockService) DeleteTeam(ctx context.Context, teamName string) (teamsrv.DeleteTeamResult, error) {
+	args := m.Called(ctx, teamName)
+	return args.Get(0).(teamsrv.DeleteTeamResult), args.Error(1)
+}
+
 func TestHandler_CreateTeam(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -134,6 +167,54 @@ func TestHandler_CreateTeam(t *testing.T) {
 				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
 			},
 		},
+		{
+			name: "invalid member user_id",
+			requestBody: map[string]interface{}{
+				"team_name": "backend",
+				"members": []map[string]interface{}{
+					{"user_id": "user-001", "username": "alice", "is_active": true},
+					{"user_id": "user 002", "username": "", "is_active": true},
+				},
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Error api.Error `json:"error"`
+				}
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "request validation failed", response.Error.Message)
+				assert.Equal(t, []validation.FieldError{
+					{Field: "members[1].user_id", Message: "must be at most 64 characters and match ^[A-Za-z0-9._-]+$"},
+					{Field: "members[1].username", Message: "is required"},
+				}, response.Error.Fields)
+			},
+		},
+		{
+			name: "duplicate user_id in members",
+			requestBody: map[string]interface{}{
+				"team_name": "backend",
+				"members": []map[string]interface{}{
+					{"user_id": "user-001", "username": "alice", "is_active": true},
+					{"user_id": "user-001", "username": "alice2", "is_active": true},
+				},
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "must not contain duplicate user_id user-001",
+		},
+		{
+			name: "team name with surrounding spaces",
+			requestBody: map[string]interface{}{
+				"team_name": " backend ",
+				"members":   []map[string]interface{}{},
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "team_name",
+		},
 		{
 			name: "team already exists",
 			requestBody: CreateTeamRequest{
@@ -183,7 +264,8 @@ func TestHandler_CreateTeam(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 		{
@@ -257,7 +339,8 @@ func TestHandler_CreateTeam(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.POST("/team/add", handler.CreateTeam)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			var bodyBytes []byte
 			var err error
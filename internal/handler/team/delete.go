@@ -0,0 +1,19 @@
+package team
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) DeleteTeam(c *gin.Context, params models.DeleteTeamParams) {
+	result, err := h.service.DeleteTeam(c.Request.Context(), params.TeamName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response DeleteTeamResponse
+	response.FillFromService(result)
+	api.SendOk(c, response)
+}
@@ -0,0 +1,120 @@
+package team
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_DeleteTeam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful delete",
+			queryParams: "team_name=backend",
+			setupMock: func(m *mockService) {
+				m.On("DeleteTeam", mock.Anything, "backend").Return(teamsrv.DeleteTeamResult{
+					TeamName:        "backend",
+					DetachedUserIDs: []string{"u1", "u2"},
+					ReassignedReviews: []teamsrv.ReassignedReview{
+						{PullRequestID: "pr-1", OldReviewerID: "u2"},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response DeleteTeamResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "backend", response.TeamName)
+				assert.Equal(t, []string{"u1", "u2"}, response.DetachedUserIDs)
+				assert.Equal(t, []ReassignedReview{{PullRequestID: "pr-1", OldReviewerID: "u2"}}, response.ReassignedReviews)
+			},
+		},
+		{
+			name:           "missing team_name",
+			queryParams:    "",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "team not found",
+			queryParams: "team_name=nonexistent",
+			setupMock: func(m *mockService) {
+				m.On("DeleteTeam", mock.Anything, "nonexistent").Return(teamsrv.DeleteTeamResult{}, teamsrv.ErrTeamNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+		{
+			name:        "internal server error",
+			queryParams: "team_name=backend",
+			setupMock: func(m *mockService) {
+				m.On("DeleteTeam", mock.Anything, "backend").Return(teamsrv.DeleteTeamResult{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.FatalLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			url := "/team/delete"
+			if tt.queryParams != "" {
+				url += "?" + tt.queryParams
+			}
+
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
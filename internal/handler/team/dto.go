@@ -5,14 +5,15 @@ import (
 )
 
 type MemberTeam struct {
-	UserID   string `json:"user_id" binding:"required"`
-	Username string `json:"username" binding:"required"`
+	UserID   string `json:"user_id" binding:"required,id"`
+	Username string `json:"username" binding:"required,name"`
+	Role     string `json:"role,omitempty" binding:"omitempty,oneof=member lead"`
 	IsActive bool   `json:"is_active"`
 }
 
 type Team struct {
-	TeamName string       `json:"team_name" binding:"required"`
-	Members  []MemberTeam `json:"members" binding:"required,dive"`
+	TeamName string       `json:"team_name" binding:"required,name"`
+	Members  []MemberTeam `json:"members" binding:"required,maxmembers,unique=UserID,dive"`
 }
 
 type CreateTeamRequest = Team
@@ -25,12 +26,100 @@ type GetTeamResponse struct {
 	Team Team `json:"team"`
 }
 
+type TeamSummary struct {
+	TeamName           string `json:"team_name"`
+	MembersCount       int    `json:"members_count"`
+	ActiveMembersCount int    `json:"active_members_count"`
+	OpenPRsCount       int    `json:"open_prs_count"`
+}
+
+type ListTeamsResponse struct {
+	Teams  []TeamSummary `json:"teams"`
+	Total  int           `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+func (r *ListTeamsResponse) FillFromService(s teamsrv.TeamList) {
+	teams := make([]TeamSummary, len(s.Teams))
+	for i, t := range s.Teams {
+		teams[i] = TeamSummary{
+			TeamName:           t.TeamName,
+			MembersCount:       t.MembersCount,
+			ActiveMembersCount: t.ActiveMembersCount,
+			OpenPRsCount:       t.OpenPRsCount,
+		}
+	}
+
+	r.Teams = teams
+	r.Total = s.Total
+	r.Limit = s.Limit
+	r.Offset = s.Offset
+}
+
+type AddMembersRequest = Team
+
+type RemoveMembersRequest struct {
+	TeamName string   `json:"team_name" binding:"required,name"`
+	UserIDs  []string `json:"user_ids" binding:"required,min=1,dive,required,id"`
+}
+
+type RenameTeamRequest struct {
+	TeamName    string `json:"team_name" binding:"required,name"`
+	NewTeamName string `json:"new_team_name" binding:"required,name"`
+}
+
+type ReassignedReview struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+type TeamUpdateResponse struct {
+	Team              Team               `json:"team"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+type RenameTeamResponse struct {
+	Team Team `json:"team"`
+}
+
+type DeleteTeamResponse struct {
+	TeamName          string             `json:"team_name"`
+	DetachedUserIDs   []string           `json:"detached_user_ids"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+func (r *TeamUpdateResponse) FillFromService(s teamsrv.TeamUpdateResult) {
+	r.Team.FillFromService(s.Team)
+	r.ReassignedReviews = reassignedReviewsFromService(s.ReassignedReviews)
+}
+
+func (r *DeleteTeamResponse) FillFromService(s teamsrv.DeleteTeamResult) {
+	r.TeamName = s.TeamName
+	r.DetachedUserIDs = s.DetachedUserIDs
+	r.ReassignedReviews = reassignedReviewsFromService(s.ReassignedReviews)
+}
+
+func reassignedReviewsFromService(reviews []teamsrv.ReassignedReview) []ReassignedReview {
+	reassigned := make([]ReassignedReview, len(reviews))
+	for i, review := range reviews {
+		reassigned[i] = ReassignedReview{
+			PullRequestID: review.PullRequestID,
+			OldReviewerID: review.OldReviewerID,
+			NewReviewerID: review.NewReviewerID,
+		}
+	}
+	return reassigned
+}
+
 func (t *Team) ToService() teamsrv.Team {
 	members := make([]teamsrv.TeamMember, len(t.Members))
 	for i, m := range t.Members {
 		members[i] = teamsrv.TeamMember{
 			UserID:   m.UserID,
 			Username: m.Username,
+			Role:     m.Role,
 			IsActive: m.IsActive,
 		}
 	}
@@ -47,6 +136,7 @@ func (t *Team) FillFromService(s teamsrv.Team) {
 		members[i] = MemberTeam{
 			UserID:   m.UserID,
 			Username: m.Username,
+			Role:     m.Role,
 			IsActive: m.IsActive,
 		}
 	}
@@ -54,3 +144,72 @@ func (t *Team) FillFromService(s teamsrv.Team) {
 	t.TeamName = s.TeamName
 	t.Members = members
 }
+
+type ImportUser struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type Membership struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type ImportDiff struct {
+	CreatedTeams      []string           `json:"created_teams"`
+	CreatedUsers      []ImportUser       `json:"created_users"`
+	UpdatedUsers      []ImportUser       `json:"updated_users"`
+	AddedMembers      []Membership       `json:"added_members"`
+	RemovedMembers    []Membership       `json:"removed_members"`
+	DeactivatedUsers  []ImportUser       `json:"deactivated_users"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+type ImportTeamsResponse struct {
+	Applied bool       `json:"applied"`
+	Diff    ImportDiff `json:"diff"`
+}
+
+func (r *ImportTeamsResponse) FillFromService(s teamsrv.ImportResult) {
+	toImportUsers := func(users []teamsrv.ImportUser) []ImportUser {
+		result := make([]ImportUser, len(users))
+		for i, u := range users {
+			result[i] = ImportUser{
+				UserID:   u.UserID,
+				Username: u.Username,
+				IsActive: u.IsActive,
+			}
+		}
+		return result
+	}
+
+	toMemberships := func(memberships []teamsrv.Membership) []Membership {
+		result := make([]Membership, len(memberships))
+		for i, m := range memberships {
+			result[i] = Membership{
+				TeamName: m.TeamName,
+				UserID:   m.UserID,
+				Username: m.Username,
+			}
+		}
+		return result
+	}
+
+	createdTeams := s.Diff.CreatedTeams
+	if createdTeams == nil {
+		createdTeams = []string{}
+	}
+
+	r.Applied = s.Applied
+	r.Diff = ImportDiff{
+		CreatedTeams:      createdTeams,
+		CreatedUsers:      toImportUsers(s.Diff.CreatedUsers),
+		UpdatedUsers:      toImportUsers(s.Diff.UpdatedUsers),
+		AddedMembers:      toMemberships(s.Diff.AddedMembers),
+		RemovedMembers:    toMemberships(s.Diff.RemovedMembers),
+		DeactivatedUsers:  toImportUsers(s.Diff.DeactivatedUsers),
+		ReassignedReviews: reassignedReviewsFromService(s.Diff.ReassignedReviews),
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
 	"github.com/gin-gonic/gin"
@@ -101,7 +103,7 @@ func TestHandler_GetTeam(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
-				assert.Contains(t, w.Body.String(), "team_name parameter is required")
+				assert.Contains(t, w.Body.String(), "Query argument team_name is required")
 			},
 		},
 		{
@@ -115,7 +117,7 @@ func TestHandler_GetTeam(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
-				assert.Contains(t, w.Body.String(), "team_name parameter is required")
+				assert.Contains(t, w.Body.String(), "Query argument team_name is required")
 			},
 		},
 		{
@@ -147,7 +149,8 @@ func TestHandler_GetTeam(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 	}
@@ -165,7 +168,8 @@ func TestHandler_GetTeam(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.GET("/team/get", handler.GetTeam)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			url := "/team/get"
 			if tt.queryParams != "" {
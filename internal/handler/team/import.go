@@ -0,0 +1,52 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/roster"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// maxRosterSize ограничение на размер тела запроса импорта
+const maxRosterSize = 10 << 20
+
+func (h *Handler) ImportTeams(c *gin.Context, params models.ImportTeamsParams) {
+	format, err := roster.FormatFromContentType(c.GetHeader("Content-Type"))
+	if params.Format != nil {
+		format, err = roster.Format(*params.Format), nil
+	}
+	if err != nil {
+		api.SendError(c, http.StatusBadRequest, api.Error{
+			Code:    "INVALID_REQUEST",
+			Message: "unsupported roster format, use application/yaml, application/json or text/csv",
+		})
+		return
+	}
+
+	parsed, err := roster.Parse(http.MaxBytesReader(c.Writer, c.Request.Body, maxRosterSize), format)
+	if err != nil {
+		api.SendError(c, http.StatusBadRequest, api.Error{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		})
+		return
+	}
+	if err := binding.Validator.ValidateStruct(parsed); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	result, err := h.service.ImportTeams(c.Request.Context(), parsed.ToService(), api.Deref(params.DryRun))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response ImportTeamsResponse
+	response.FillFromService(result)
+
+	api.SendOk(c, response)
+}
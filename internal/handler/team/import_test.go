@@ -0,0 +1,206 @@
+package team
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ImportTeams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	yamlRoster := `teams:
+  - team_name: backend
+    members:
+      - {user_id: u1, username: Alice}
+      - {user_id: u2, username: Bob, is_active: false}
+`
+	backendTeams := []teamsrv.Team{
+		{TeamName: "backend", Members: []teamsrv.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: false},
+		}},
+	}
+	diff := teamsrv.ImportDiff{
+		CreatedTeams: []string{"backend"},
+		CreatedUsers: []teamsrv.ImportUser{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: false},
+		},
+		AddedMembers: []teamsrv.Membership{
+			{TeamName: "backend", UserID: "u1", Username: "Alice"},
+			{TeamName: "backend", UserID: "u2", Username: "Bob"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		contentType    string
+		body           string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful yaml import",
+			contentType: "application/yaml",
+			body:        yamlRoster,
+			setupMock: func(m *mockService) {
+				m.On("ImportTeams", mock.Anything, backendTeams, false).
+					Return(teamsrv.ImportResult{Diff: diff, Applied: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ImportTeamsResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.True(t, response.Applied)
+				assert.Equal(t, []string{"backend"}, response.Diff.CreatedTeams)
+				assert.Len(t, response.Diff.CreatedUsers, 2)
+				assert.Len(t, response.Diff.AddedMembers, 2)
+				assert.Empty(t, response.Diff.RemovedMembers)
+				assert.NotNil(t, response.Diff.RemovedMembers)
+			},
+		},
+		{
+			name:        "csv dry run",
+			query:       "dry_run=true",
+			contentType: "text/csv",
+			body:        "team_name,user_id,username,is_active\nbackend,u1,Alice,\nbackend,u2,Bob,false\n",
+			setupMock: func(m *mockService) {
+				m.On("ImportTeams", mock.Anything, backendTeams, true).
+					Return(teamsrv.ImportResult{Diff: diff}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ImportTeamsResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.False(t, response.Applied)
+				assert.Len(t, response.Diff.CreatedUsers, 2)
+			},
+		},
+		{
+			name:        "format query overrides content type",
+			query:       "format=yaml",
+			contentType: "text/plain",
+			body:        yamlRoster,
+			setupMock: func(m *mockService) {
+				m.On("ImportTeams", mock.Anything, backendTeams, false).
+					Return(teamsrv.ImportResult{Diff: diff, Applied: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unsupported content type",
+			contentType:    "text/plain",
+			body:           yamlRoster,
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:           "invalid dry_run",
+			query:          "dry_run=maybe",
+			contentType:    "application/yaml",
+			body:           yamlRoster,
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:           "malformed csv",
+			contentType:    "text/csv",
+			body:           "team_name,user_id\nbackend,u1\n",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:           "invalid user_id in roster",
+			contentType:    "text/csv",
+			body:           "team_name,user_id,username,is_active\nbackend,u 1,Alice,\n",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "teams[0].members[0].user_id",
+		},
+		{
+			name:        "invalid roster",
+			contentType: "application/yaml",
+			body:        yamlRoster,
+			setupMock: func(m *mockService) {
+				m.On("ImportTeams", mock.Anything, backendTeams, false).
+					Return(teamsrv.ImportResult{}, fmt.Errorf("%w: user %q is listed in teams %q and %q", teamsrv.ErrInvalidRoster, "u1", "a", "b"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "internal server error",
+			contentType: "application/yaml",
+			body:        yamlRoster,
+			setupMock: func(m *mockService) {
+				m.On("ImportTeams", mock.Anything, backendTeams, false).
+					Return(teamsrv.ImportResult{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.FatalLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			url := "/team/import"
+			if tt.query != "" {
+				url += "?" + tt.query
+			}
+
+			req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tt.body))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", tt.contentType)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package team
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListTeams(c *gin.Context, params models.ListTeamsParams) {
+	result, err := h.service.ListTeams(c.Request.Context(), teamsrv.ListTeamsParams{
+		Prefix: api.Deref(params.Prefix),
+		Limit:  api.Deref(params.Limit),
+		Offset: api.Deref(params.Offset),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response ListTeamsResponse
+	response.FillFromService(result)
+
+	api.SendOk(c, response)
+}
@@ -0,0 +1,147 @@
+package team
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ListTeams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful list",
+			queryParams: "prefix=back&limit=10&offset=20",
+			setupMock: func(m *mockService) {
+				m.On("ListTeams", mock.Anything, teamsrv.ListTeamsParams{Prefix: "back", Limit: 10, Offset: 20}).Return(teamsrv.TeamList{
+					Teams: []teamsrv.TeamSummary{
+						{TeamName: "backend", MembersCount: 5, ActiveMembersCount: 4, OpenPRsCount: 2},
+					},
+					Total:  21,
+					Limit:  10,
+					Offset: 20,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ListTeamsResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response.Teams, 1)
+				assert.Equal(t, TeamSummary{TeamName: "backend", MembersCount: 5, ActiveMembersCount: 4, OpenPRsCount: 2}, response.Teams[0])
+				assert.Equal(t, 21, response.Total)
+				assert.Equal(t, 10, response.Limit)
+				assert.Equal(t, 20, response.Offset)
+			},
+		},
+		{
+			name:        "empty list without parameters",
+			queryParams: "",
+			setupMock: func(m *mockService) {
+				m.On("ListTeams", mock.Anything, teamsrv.ListTeamsParams{}).Return(teamsrv.TeamList{
+					Limit: teamsrv.DefaultListLimit,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), `"teams":[]`)
+				assert.Contains(t, w.Body.String(), `"total":0`)
+			},
+		},
+		{
+			name:           "invalid limit",
+			queryParams:    "limit=ten",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "Invalid format for parameter limit")
+			},
+		},
+		{
+			name:        "limit out of range",
+			queryParams: "limit=1000",
+			setupMock: func(m *mockService) {
+				m.On("ListTeams", mock.Anything, teamsrv.ListTeamsParams{Limit: 1000}).
+					Return(teamsrv.TeamList{}, fmt.Errorf("%w: limit must be between 1 and 100", teamsrv.ErrInvalidPagination))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "limit must be between 1 and 100")
+			},
+		},
+		{
+			name:        "internal server error",
+			queryParams: "",
+			setupMock: func(m *mockService) {
+				m.On("ListTeams", mock.Anything, teamsrv.ListTeamsParams{}).Return(teamsrv.TeamList{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+			validateBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			url := "/team/list"
+			if tt.queryParams != "" {
+				url += "?" + tt.queryParams
+			}
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
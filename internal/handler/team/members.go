@@ -0,0 +1,48 @@
+package team
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) AddMembers(c *gin.Context) {
+	var req AddMembersRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	serviceTeam := req.ToService()
+
+	result, err := h.service.AddMembers(c.Request.Context(), serviceTeam.TeamName, serviceTeam.Members)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response TeamUpdateResponse
+	response.FillFromService(result)
+
+	api.SendOk(c, response)
+}
+
+func (h *Handler) RemoveMembers(c *gin.Context) {
+	var req RemoveMembersRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	result, err := h.service.RemoveMembers(c.Request.Context(), req.TeamName, req.UserIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response TeamUpdateResponse
+	response.FillFromService(result)
+
+	api.SendOk(c, response)
+}
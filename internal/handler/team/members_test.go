@@ -0,0 +1,233 @@
+package team
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_AddMembers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "successful add",
+			requestBody: AddMembersRequest{
+				TeamName: "backend",
+				Members: []MemberTeam{
+					{UserID: "u3", Username: "Carol", IsActive: true},
+				},
+			},
+			setupMock: func(m *mockService) {
+				m.On("AddMembers", mock.Anything, "backend", []teamsrv.TeamMember{
+					{UserID: "u3", Username: "Carol", IsActive: true},
+				}).Return(teamsrv.TeamUpdateResult{
+					Team: teamsrv.Team{
+						TeamName: "backend",
+						Members: []teamsrv.TeamMember{
+							{UserID: "u1", Username: "Alice", IsActive: true},
+							{UserID: "u3", Username: "Carol", IsActive: true},
+						},
+					},
+					ReassignedReviews: []teamsrv.ReassignedReview{
+						{PullRequestID: "pr-1", OldReviewerID: "u3", NewReviewerID: "u8"},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response TeamUpdateResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "backend", response.Team.TeamName)
+				assert.Len(t, response.Team.Members, 2)
+				assert.Equal(t, []ReassignedReview{
+					{PullRequestID: "pr-1", OldReviewerID: "u3", NewReviewerID: "u8"},
+				}, response.ReassignedReviews)
+			},
+		},
+		{
+			name: "missing members",
+			requestBody: map[string]interface{}{
+				"team_name": "backend",
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name: "team not found",
+			requestBody: AddMembersRequest{
+				TeamName: "nonexistent",
+				Members:  []MemberTeam{{UserID: "u1", Username: "Alice", IsActive: true}},
+			},
+			setupMock: func(m *mockService) {
+				m.On("AddMembers", mock.Anything, "nonexistent", mock.Anything).
+					Return(teamsrv.TeamUpdateResult{}, teamsrv.ErrTeamNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+		{
+			name: "internal server error",
+			requestBody: AddMembersRequest{
+				TeamName: "backend",
+				Members:  []MemberTeam{{UserID: "u1", Username: "Alice", IsActive: true}},
+			},
+			setupMock: func(m *mockService) {
+				m.On("AddMembers", mock.Anything, "backend", mock.Anything).
+					Return(teamsrv.TeamUpdateResult{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveTeamJSON(t, tt.setupMock, http.MethodPatch, "/team/addMembers", tt.requestBody,
+				func(h *Handler) gin.HandlerFunc { return h.AddMembers })
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestHandler_RemoveMembers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "successful remove with dropped reviewer",
+			requestBody: RemoveMembersRequest{
+				TeamName: "backend",
+				UserIDs:  []string{"u2"},
+			},
+			setupMock: func(m *mockService) {
+				m.On("RemoveMembers", mock.Anything, "backend", []string{"u2"}).Return(teamsrv.TeamUpdateResult{
+					Team: teamsrv.Team{
+						TeamName: "backend",
+						Members:  []teamsrv.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+					},
+					ReassignedReviews: []teamsrv.ReassignedReview{
+						{PullRequestID: "pr-1", OldReviewerID: "u2"},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response map[string]interface{}
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				reviews := response["reassigned_reviews"].([]interface{})
+				assert.Len(t, reviews, 1)
+				assert.NotContains(t, reviews[0], "new_reviewer_id")
+			},
+		},
+		{
+			name: "empty user_ids",
+			requestBody: RemoveMembersRequest{
+				TeamName: "backend",
+				UserIDs:  []string{},
+			},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name: "user is not a member",
+			requestBody: RemoveMembersRequest{
+				TeamName: "backend",
+				UserIDs:  []string{"u9"},
+			},
+			setupMock: func(m *mockService) {
+				m.On("RemoveMembers", mock.Anything, "backend", []string{"u9"}).
+					Return(teamsrv.TeamUpdateResult{}, fmt.Errorf("%w: user %q is not a member of team %q", teamsrv.ErrNotTeamMember, "u9", "backend"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "is not a member of team",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveTeamJSON(t, tt.setupMock, http.MethodPatch, "/team/removeMembers", tt.requestBody,
+				func(h *Handler) gin.HandlerFunc { return h.RemoveMembers })
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+		})
+	}
+}
+
+// serveTeamJSON выполняет JSON-запрос к одному обработчику с замоканным сервисом
+func serveTeamJSON(t *testing.T, setupMock func(*mockService), method, path string, body interface{}, handlerFunc func(*Handler) gin.HandlerFunc) *httptest.ResponseRecorder {
+	mockSvc := new(mockService)
+	setupMock(mockSvc)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	handler := &Handler{
+		service: mockSvc,
+		logger:  logger,
+	}
+
+	router := gin.New()
+	router.Use(api.ErrorMiddleware(logger))
+	router.Handle(method, path, handlerFunc(handler))
+
+	bodyBytes, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(method, path, bytes.NewBuffer(bodyBytes))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	mockSvc.AssertExpectations(t)
+
+	return w
+}
@@ -0,0 +1,28 @@
+package team
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) RenameTeam(c *gin.Context) {
+	var req RenameTeamRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	resultTeam, err := h.service.RenameTeam(c.Request.Context(), req.TeamName, req.NewTeamName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var handlerTeam Team
+	handlerTeam.FillFromService(resultTeam)
+
+	api.SendOk(c, RenameTeamResponse{
+		Team: handlerTeam,
+	})
+}
@@ -0,0 +1,98 @@
+package team
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_RenameTeam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful rename",
+			requestBody: RenameTeamRequest{TeamName: "backend", NewTeamName: "platform"},
+			setupMock: func(m *mockService) {
+				m.On("RenameTeam", mock.Anything, "backend", "platform").Return(teamsrv.Team{
+					TeamName: "platform",
+					Members:  []teamsrv.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response RenameTeamResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "platform", response.Team.TeamName)
+				assert.Len(t, response.Team.Members, 1)
+			},
+		},
+		{
+			name:           "missing new_team_name",
+			requestBody:    map[string]interface{}{"team_name": "backend"},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+		},
+		{
+			name:        "new name already exists",
+			requestBody: RenameTeamRequest{TeamName: "backend", NewTeamName: "frontend"},
+			setupMock: func(m *mockService) {
+				m.On("RenameTeam", mock.Anything, "backend", "frontend").Return(teamsrv.Team{}, teamsrv.ErrTeamExists)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  string(models.TEAMEXISTS),
+		},
+		{
+			name:        "team not found",
+			requestBody: RenameTeamRequest{TeamName: "nonexistent", NewTeamName: "platform"},
+			setupMock: func(m *mockService) {
+				m.On("RenameTeam", mock.Anything, "nonexistent", "platform").Return(teamsrv.Team{}, teamsrv.ErrTeamNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+		},
+		{
+			name:        "internal server error",
+			requestBody: RenameTeamRequest{TeamName: "backend", NewTeamName: "platform"},
+			setupMock: func(m *mockService) {
+				m.On("RenameTeam", mock.Anything, "backend", "platform").Return(teamsrv.Team{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveTeamJSON(t, tt.setupMock, http.MethodPatch, "/team/rename", tt.requestBody,
+				func(h *Handler) gin.HandlerFunc { return h.RenameTeam })
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+		})
+	}
+}
@@ -7,6 +7,10 @@ import (
 )
 
 type ServiceUser interface {
+	GetUser(ctx context.Context, userID string) (usersrv.User, error)
+	ListUsers(ctx context.Context, params usersrv.ListUsersParams) (usersrv.UserList, error)
+	UpdateUser(ctx context.Context, userID, username string) (usersrv.User, error)
+	SetExpertise(ctx context.Context, userID string, tags []string) (usersrv.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (usersrv.User, error)
-	GetReview(ctx context.Context, userID string) ([]usersrv.PullRequestShort, error)
+	GetReview(ctx context.Context, params usersrv.ReviewParams) (usersrv.ReviewList, error)
 }
@@ -1,47 +1,127 @@
 package user
 
 import (
+	"time"
+
 	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
 )
 
 type PullRequestShort struct {
-	PullRequestID   string `json:"pull_request_id" binding:"required"`
-	PullRequestName string `json:"pull_request_name" binding:"required"`
-	AuthorID        string `json:"author_id" binding:"required"`
-	Status          string `json:"status" binding:"required,oneof=OPEN MERGED"`
+	PullRequestID   string     `json:"pull_request_id" binding:"required"`
+	PullRequestName string     `json:"pull_request_name" binding:"required"`
+	AuthorID        string     `json:"author_id" binding:"required"`
+	AuthorUsername  string     `json:"author_username"`
+	Status          string     `json:"status" binding:"required,oneof=OPEN MERGED"`
+	CoReviewers     []string   `json:"co_reviewers"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	AgeSeconds      int64      `json:"age_seconds"`
 }
 
 type SetIsActiveRequest struct {
-	UserID   string `json:"user_id" binding:"required"`
+	UserID   string `json:"user_id" binding:"required,id"`
 	IsActive *bool  `json:"is_active" binding:"required"`
 }
 
 type User struct {
-	UserID   string `json:"user_id" binding:"required"`
-	Username string `json:"username" binding:"required"`
-	TeamName string `json:"team_name" binding:"required"`
-	IsActive bool   `json:"is_active" binding:"required"`
+	UserID    string   `json:"user_id" binding:"required"`
+	Username  string   `json:"username" binding:"required"`
+	TeamName  string   `json:"team_name" binding:"required"`
+	TeamNames []string `json:"team_names"`
+	Expertise []string `json:"expertise"`
+	IsActive  bool     `json:"is_active" binding:"required"`
 }
 
 type SetIsActiveResponse struct {
 	User User `json:"user"`
 }
 
+type GetUserResponse struct {
+	User User `json:"user"`
+}
+
+type ListUsersResponse struct {
+	Users  []User `json:"users"`
+	Total  int    `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
+
+type UpdateUserRequest struct {
+	UserID   string `json:"user_id" binding:"required,id"`
+	Username string `json:"username" binding:"required,name"`
+}
+
+type UpdateUserResponse struct {
+	User User `json:"user"`
+}
+
+type SetExpertiseRequest struct {
+	UserID    string   `json:"user_id" binding:"required,id"`
+	Expertise []string `json:"expertise" binding:"required"`
+}
+
+type SetExpertiseResponse struct {
+	User User `json:"user"`
+}
+
 type GetReviewResponse struct {
 	UserID       string             `json:"user_id"`
 	PullRequests []PullRequestShort `json:"pull_requests"`
+	Total        int                `json:"total"`
+	Limit        int                `json:"limit"`
+	Offset       int                `json:"offset"`
 }
 
 func (p *PullRequestShort) FillFromService(s usersrv.PullRequestShort) {
 	p.PullRequestID = s.PullRequestID
 	p.PullRequestName = s.PullRequestName
 	p.AuthorID = s.AuthorID
+	p.AuthorUsername = s.AuthorUsername
 	p.Status = s.Status
+	p.CoReviewers = s.CoReviewers
+	if p.CoReviewers == nil {
+		p.CoReviewers = []string{}
+	}
+	p.CreatedAt = s.CreatedAt
+	p.AgeSeconds = int64(s.Age.Seconds())
 }
 
 func (u *User) FillFromService(s usersrv.User) {
 	u.UserID = s.UserID
 	u.Username = s.Username
 	u.TeamName = s.TeamName
+	u.TeamNames = s.TeamNames
+	if u.TeamNames == nil {
+		u.TeamNames = []string{}
+	}
+	u.Expertise = s.Expertise
+	if u.Expertise == nil {
+		u.Expertise = []string{}
+	}
 	u.IsActive = s.IsActive
 }
+
+func (r *ListUsersResponse) FillFromService(s usersrv.UserList) {
+	users := make([]User, len(s.Users))
+	for i, u := range s.Users {
+		users[i].FillFromService(u)
+	}
+
+	r.Users = users
+	r.Total = s.Total
+	r.Limit = s.Limit
+	r.Offset = s.Offset
+}
+
+func (r *GetReviewResponse) FillFromService(userID string, s usersrv.ReviewList) {
+	prs := make([]PullRequestShort, len(s.PullRequests))
+	for i, pr := range s.PullRequests {
+		prs[i].FillFromService(pr)
+	}
+
+	r.UserID = userID
+	r.PullRequests = prs
+	r.Total = s.Total
+	r.Limit = s.Limit
+	r.Offset = s.Offset
+}
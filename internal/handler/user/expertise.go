@@ -0,0 +1,28 @@
+package user
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) SetExpertise(c *gin.Context) {
+	var req SetExpertiseRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	resultUser, err := h.service.SetExpertise(c.Request.Context(), req.UserID, req.Expertise)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var handlerUser User
+	handlerUser.FillFromService(resultUser)
+
+	api.SendOk(c, SetExpertiseResponse{
+		User: handlerUser,
+	})
+}
@@ -0,0 +1,135 @@
+package user
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_SetExpertise(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful set",
+			requestBody: SetExpertiseRequest{UserID: "user-001", Expertise: []string{"Go", "postgres"}},
+			setupMock: func(m *mockService) {
+				m.On("SetExpertise", mock.Anything, "user-001", []string{"Go", "postgres"}).Return(usersrv.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamNames: []string{"backend"},
+					Expertise: []string{"go", "postgres"},
+					IsActive:  true,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response SetExpertiseResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "user-001", response.User.UserID)
+				assert.Equal(t, []string{"go", "postgres"}, response.User.Expertise)
+			},
+		},
+		{
+			name:           "missing expertise",
+			requestBody:    map[string]string{"user_id": "user-001"},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody:   nil,
+		},
+		{
+			name:        "invalid tag",
+			requestBody: SetExpertiseRequest{UserID: "user-001", Expertise: []string{""}},
+			setupMock: func(m *mockService) {
+				m.On("SetExpertise", mock.Anything, "user-001", []string{""}).
+					Return(usersrv.User{}, fmt.Errorf("%w: %q", usersrv.ErrInvalidTag, ""))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody:   nil,
+		},
+		{
+			name:        "user not found",
+			requestBody: SetExpertiseRequest{UserID: "user-999", Expertise: []string{"go"}},
+			setupMock: func(m *mockService) {
+				m.On("SetExpertise", mock.Anything, "user-999", []string{"go"}).Return(usersrv.User{}, usersrv.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+			validateBody:   nil,
+		},
+		{
+			name:        "internal server error",
+			requestBody: SetExpertiseRequest{UserID: "user-001", Expertise: []string{"go"}},
+			setupMock: func(m *mockService) {
+				m.On("SetExpertise", mock.Anything, "user-001", []string{"go"}).Return(usersrv.User{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+			validateBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/users/setExpertise", bytes.NewBuffer(bodyBytes))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package user
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) GetUser(c *gin.Context, params models.GetUserParams) {
+	resultUser, err := h.service.GetUser(c.Request.Context(), params.UserId)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var handlerUser User
+	handlerUser.FillFromService(resultUser)
+
+	api.SendOk(c, GetUserResponse{
+		User: handlerUser,
+	})
+}
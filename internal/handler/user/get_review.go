@@ -1,8 +1,7 @@
 package user
 
 import (
-	"errors"
-	"net/http"
+	"strings"
 
 	"github.com/aabbuukkaarr8/PRService/internal/api"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
@@ -10,41 +9,28 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func (h *Handler) GetReview(c *gin.Context) {
-	userID := c.Query("user_id")
-	if userID == "" {
-		api.SendError(c, http.StatusBadRequest, api.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "user_id query parameter is required",
-		})
-		return
+func (h *Handler) GetReview(c *gin.Context, params models.GetReviewParams) {
+	reviewParams := usersrv.ReviewParams{
+		UserID: params.UserId,
+		Order:  string(api.Deref(params.Order)),
+		Limit:  api.Deref(params.Limit),
+		Offset: api.Deref(params.Offset),
 	}
 
-	resultPRs, err := h.service.GetReview(c.Request.Context(), userID)
-	if err != nil {
-		switch {
-		case errors.Is(err, usersrv.ErrUserNotFound):
-			api.SendError(c, http.StatusNotFound, api.Error{
-				Code:    models.NOTFOUND,
-				Message: "user not found",
-			})
-		default:
-			h.logger.WithError(err).WithField("user_id", userID).Error("Failed to get user reviews")
-			api.SendError(c, http.StatusInternalServerError, api.Error{
-				Code:    "INTERNAL_ERROR",
-				Message: err.Error(),
-			})
+	for _, status := range strings.Split(api.Deref(params.Status), ",") {
+		if status = strings.ToUpper(strings.TrimSpace(status)); status != "" {
+			reviewParams.Statuses = append(reviewParams.Statuses, status)
 		}
-		return
 	}
 
-	handlerPRs := make([]PullRequestShort, len(resultPRs))
-	for i, pr := range resultPRs {
-		handlerPRs[i].FillFromService(pr)
+	result, err := h.service.GetReview(c.Request.Context(), reviewParams)
+	if err != nil {
+		_ = c.Error(err)
+		return
 	}
 
-	api.SendOk(c, GetReviewResponse{
-		UserID:       userID,
-		PullRequests: handlerPRs,
-	})
+	var response GetReviewResponse
+	response.FillFromService(params.UserId, result)
+
+	api.SendOk(c, response)
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
 	"github.com/gin-gonic/gin"
@@ -20,6 +24,26 @@ type mockService struct {
 	mock.Mock
 }
 
+func (m *mockService) GetUser(ctx context.Context, userID string) (usersrv.User, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(usersrv.User), args.Error(1)
+}
+
+func (m *mockService) ListUsers(ctx context.Context, params usersrv.ListUsersParams) (usersrv.UserList, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(usersrv.UserList), args.Error(1)
+}
+
+func (m *mockService) UpdateUser(ctx context.Context, userID, username string) (usersrv.User, error) {
+	args := m.Called(ctx, userID, username)
+	return args.Get(0).(usersrv.User), args.Error(1)
+}
+
+func (m *mockService) SetExpertise(ctx context.Context, userID string, tags []string) (usersrv.User, error) {
+	args := m.Called(ctx, userID, tags)
+	return args.Get(0).(usersrv.User), args.Error(1)
+}
+
 func (m *mockService) SetIsActive(ctx context.Context, userID string, isActive bool) (usersrv.User, error) {
 	args := m.Called(ctx, userID, isActive)
 	if args.Get(0) == nil {
@@ -28,12 +52,9 @@ func (m *mockService) SetIsActive(ctx context.Context, userID string, isActive b
 	return args.Get(0).(usersrv.User), args.Error(1)
 }
 
-func (m *mockService) GetReview(ctx context.Context, userID string) ([]usersrv.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).([]usersrv.PullRequestShort), args.Error(1)
+func (m *mockService) GetReview(ctx context.Context, params usersrv.ReviewParams) (usersrv.ReviewList, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(usersrv.ReviewList), args.Error(1)
 }
 
 func TestHandler_GetReview(t *testing.T) {
@@ -51,9 +72,13 @@ func TestHandler_GetReview(t *testing.T) {
 			name:        "successful get with multiple PRs",
 			queryParams: "user_id=user-001",
 			setupMock: func(m *mockService) {
-				m.On("GetReview", mock.Anything, "user-001").Return([]usersrv.PullRequestShort{
-					{PullRequestID: "pr-001", PullRequestName: "Test PR 1", AuthorID: "user-002", Status: "OPEN"},
-					{PullRequestID: "pr-002", PullRequestName: "Test PR 2", AuthorID: "user-003", Status: "MERGED"},
+				m.On("GetReview", mock.Anything, usersrv.ReviewParams{UserID: "user-001"}).Return(usersrv.ReviewList{
+					PullRequests: []usersrv.PullRequestShort{
+						{PullRequestID: "pr-001", PullRequestName: "Test PR 1", AuthorID: "user-002", AuthorUsername: "bob", Status: "OPEN", CoReviewers: []string{"user-004"}, Age: 90 * time.Minute},
+						{PullRequestID: "pr-002", PullRequestName: "Test PR 2", AuthorID: "user-003", Status: "MERGED"},
+					},
+					Total: 2,
+					Limit: 50,
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -66,14 +91,25 @@ func TestHandler_GetReview(t *testing.T) {
 				assert.Len(t, response.PullRequests, 2)
 				assert.Equal(t, "pr-001", response.PullRequests[0].PullRequestID)
 				assert.Equal(t, "OPEN", response.PullRequests[0].Status)
+				assert.Equal(t, "bob", response.PullRequests[0].AuthorUsername)
+				assert.Equal(t, []string{"user-004"}, response.PullRequests[0].CoReviewers)
+				assert.Equal(t, int64(5400), response.PullRequests[0].AgeSeconds)
+				assert.Equal(t, []string{}, response.PullRequests[1].CoReviewers)
+				assert.Equal(t, 2, response.Total)
 			},
 		},
 		{
-			name:        "successful get with single PR",
-			queryParams: "user_id=user-002",
+			name:        "filters and pagination",
+			queryParams: "user_id=user-002&status=open,merged&order=desc&limit=10&offset=20",
 			setupMock: func(m *mockService) {
-				m.On("GetReview", mock.Anything, "user-002").Return([]usersrv.PullRequestShort{
-					{PullRequestID: "pr-003", PullRequestName: "Test PR 3", AuthorID: "user-001", Status: "OPEN"},
+				params := usersrv.ReviewParams{UserID: "user-002", Statuses: []string{"OPEN", "MERGED"}, Order: "desc", Limit: 10, Offset: 20}
+				m.On("GetReview", mock.Anything, params).Return(usersrv.ReviewList{
+					PullRequests: []usersrv.PullRequestShort{
+						{PullRequestID: "pr-003", PullRequestName: "Test PR 3", AuthorID: "user-001", Status: "OPEN"},
+					},
+					Total:  21,
+					Limit:  10,
+					Offset: 20,
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -90,7 +126,10 @@ func TestHandler_GetReview(t *testing.T) {
 			name:        "successful get with no PRs",
 			queryParams: "user_id=user-003",
 			setupMock: func(m *mockService) {
-				m.On("GetReview", mock.Anything, "user-003").Return([]usersrv.PullRequestShort{}, nil)
+				m.On("GetReview", mock.Anything, usersrv.ReviewParams{UserID: "user-003"}).Return(usersrv.ReviewList{
+					PullRequests: []usersrv.PullRequestShort{},
+					Limit:        50,
+				}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedError:  "",
@@ -113,7 +152,7 @@ func TestHandler_GetReview(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
-				assert.Contains(t, w.Body.String(), "user_id query parameter is required")
+				assert.Contains(t, w.Body.String(), "Query argument user_id is required")
 			},
 		},
 		{
@@ -127,14 +166,31 @@ func TestHandler_GetReview(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INVALID_REQUEST")
-				assert.Contains(t, w.Body.String(), "user_id query parameter is required")
+				assert.Contains(t, w.Body.String(), "Query argument user_id is required")
+			},
+		},
+		{
+			name:           "invalid limit",
+			queryParams:    "user_id=user-001&limit=many",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid format for parameter limit",
+		},
+		{
+			name:        "invalid status",
+			queryParams: "user_id=user-001&status=closed",
+			setupMock: func(m *mockService) {
+				m.On("GetReview", mock.Anything, usersrv.ReviewParams{UserID: "user-001", Statuses: []string{"CLOSED"}}).
+					Return(usersrv.ReviewList{}, fmt.Errorf("%w: status must be OPEN or MERGED", usersrv.ErrInvalidFilter))
 			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
 		},
 		{
 			name:        "user not found",
 			queryParams: "user_id=user-999",
 			setupMock: func(m *mockService) {
-				m.On("GetReview", mock.Anything, "user-999").Return(nil, usersrv.ErrUserNotFound)
+				m.On("GetReview", mock.Anything, usersrv.ReviewParams{UserID: "user-999"}).Return(usersrv.ReviewList{}, usersrv.ErrUserNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedError:  string(models.NOTFOUND),
@@ -150,7 +206,7 @@ func TestHandler_GetReview(t *testing.T) {
 			name:        "internal server error",
 			queryParams: "user_id=user-001",
 			setupMock: func(m *mockService) {
-				m.On("GetReview", mock.Anything, "user-001").Return(nil, errors.New("database connection failed"))
+				m.On("GetReview", mock.Anything, usersrv.ReviewParams{UserID: "user-001"}).Return(usersrv.ReviewList{}, errors.New("database connection failed"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "INTERNAL_ERROR",
@@ -159,7 +215,8 @@ func TestHandler_GetReview(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 	}
@@ -177,7 +234,8 @@ func TestHandler_GetReview(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.GET("/users/getReview", handler.GetReview)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			url := "/users/getReview"
 			if tt.queryParams != "" {
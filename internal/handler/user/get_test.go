@@ -0,0 +1,127 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_GetUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful get",
+			queryParams: "user_id=user-001",
+			setupMock: func(m *mockService) {
+				m.On("GetUser", mock.Anything, "user-001").Return(usersrv.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend", "devops"},
+					IsActive:  true,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response GetUserResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "user-001", response.User.UserID)
+				assert.Equal(t, "alice", response.User.Username)
+				assert.Equal(t, []string{"backend", "devops"}, response.User.TeamNames)
+				assert.True(t, response.User.IsActive)
+			},
+		},
+		{
+			name:           "missing user_id",
+			queryParams:    "",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody:   nil,
+		},
+		{
+			name:        "user not found",
+			queryParams: "user_id=user-999",
+			setupMock: func(m *mockService) {
+				m.On("GetUser", mock.Anything, "user-999").Return(usersrv.User{}, usersrv.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "user not found")
+			},
+		},
+		{
+			name:        "internal server error",
+			queryParams: "user_id=user-001",
+			setupMock: func(m *mockService) {
+				m.On("GetUser", mock.Anything, "user-001").Return(usersrv.User{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+			validateBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			url := "/users/get"
+			if tt.queryParams != "" {
+				url += "?" + tt.queryParams
+			}
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListUsers(c *gin.Context, params models.ListUsersParams) {
+	result, err := h.service.ListUsers(c.Request.Context(), usersrv.ListUsersParams{
+		TeamName:       api.Deref(params.TeamName),
+		IsActive:       params.IsActive,
+		UsernamePrefix: api.Deref(params.UsernamePrefix),
+		Limit:          api.Deref(params.Limit),
+		Offset:         api.Deref(params.Offset),
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var response ListUsersResponse
+	response.FillFromService(result)
+
+	api.SendOk(c, response)
+}
@@ -0,0 +1,157 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ListUsers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	active := true
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful list with filters",
+			queryParams: "team_name=backend&is_active=true&username_prefix=al&limit=10&offset=0",
+			setupMock: func(m *mockService) {
+				params := usersrv.ListUsersParams{TeamName: "backend", IsActive: &active, UsernamePrefix: "al", Limit: 10}
+				m.On("ListUsers", mock.Anything, params).Return(usersrv.UserList{
+					Users: []usersrv.User{
+						{UserID: "user-001", Username: "alice", TeamName: "backend", TeamNames: []string{"backend"}, IsActive: true},
+					},
+					Total: 1,
+					Limit: 10,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response ListUsersResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Len(t, response.Users, 1)
+				assert.Equal(t, "alice", response.Users[0].Username)
+				assert.Equal(t, 1, response.Total)
+				assert.Equal(t, 10, response.Limit)
+			},
+		},
+		{
+			name:        "user without teams",
+			queryParams: "",
+			setupMock: func(m *mockService) {
+				m.On("ListUsers", mock.Anything, usersrv.ListUsersParams{}).Return(usersrv.UserList{
+					Users: []usersrv.User{{UserID: "user-002", Username: "bob"}},
+					Total: 1,
+					Limit: usersrv.DefaultListLimit,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), `"team_names":[]`)
+			},
+		},
+		{
+			name:           "invalid is_active",
+			queryParams:    "is_active=maybe",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "Invalid format for parameter is_active")
+			},
+		},
+		{
+			name:           "invalid offset",
+			queryParams:    "offset=first",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Contains(t, w.Body.String(), "Invalid format for parameter offset")
+			},
+		},
+		{
+			name:        "limit out of range",
+			queryParams: "limit=500",
+			setupMock: func(m *mockService) {
+				m.On("ListUsers", mock.Anything, usersrv.ListUsersParams{Limit: 500}).
+					Return(usersrv.UserList{}, fmt.Errorf("%w: limit must be between 1 and 100", usersrv.ErrInvalidPagination))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody:   nil,
+		},
+		{
+			name:        "internal server error",
+			queryParams: "",
+			setupMock: func(m *mockService) {
+				m.On("ListUsers", mock.Anything, usersrv.ListUsersParams{}).Return(usersrv.UserList{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+			validateBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			url := "/users/list"
+			if tt.queryParams != "" {
+				url += "?" + tt.queryParams
+			}
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
 	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
 	"github.com/gin-gonic/gin"
@@ -154,7 +156,8 @@ func TestHandler_SetIsActive(t *testing.T) {
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				assert.NoError(t, err)
 				assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
-				assert.Contains(t, w.Body.String(), "database connection failed")
+				assert.Contains(t, w.Body.String(), "internal server error")
+				assert.NotContains(t, w.Body.String(), "database connection failed")
 			},
 		},
 		{
@@ -201,7 +204,8 @@ func TestHandler_SetIsActive(t *testing.T) {
 			}
 
 			router := gin.New()
-			router.POST("/users/setIsActive", handler.SetIsActive)
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
 
 			var bodyBytes []byte
 			var err error
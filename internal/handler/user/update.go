@@ -0,0 +1,28 @@
+package user
+
+import (
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) UpdateUser(c *gin.Context) {
+	var req UpdateUserRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		api.SendBindError(c, err)
+		return
+	}
+
+	resultUser, err := h.service.UpdateUser(c.Request.Context(), req.UserID, req.Username)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var handlerUser User
+	handlerUser.FillFromService(resultUser)
+
+	api.SendOk(c, UpdateUserResponse{
+		User: handlerUser,
+	})
+}
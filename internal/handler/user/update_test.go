@@ -0,0 +1,138 @@
+package user
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/apitest"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	usersrv "github.com/aabbuukkaarr8/PRService/internal/service/user"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_UpdateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func(*mockService)
+		expectedStatus int
+		expectedError  string
+		validateBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "successful rename",
+			requestBody: UpdateUserRequest{UserID: "user-001", Username: "alice.smith"},
+			setupMock: func(m *mockService) {
+				m.On("UpdateUser", mock.Anything, "user-001", "alice.smith").Return(usersrv.User{
+					UserID:    "user-001",
+					Username:  "alice.smith",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			validateBody: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response UpdateUserResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "user-001", response.User.UserID)
+				assert.Equal(t, "alice.smith", response.User.Username)
+			},
+		},
+		{
+			name:           "empty username",
+			requestBody:    UpdateUserRequest{UserID: "user-001"},
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody:   nil,
+		},
+		{
+			name:           "invalid JSON format",
+			requestBody:    "invalid json string",
+			setupMock:      func(m *mockService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "INVALID_REQUEST",
+			validateBody:   nil,
+		},
+		{
+			name:        "user not found",
+			requestBody: UpdateUserRequest{UserID: "user-999", Username: "ghost"},
+			setupMock: func(m *mockService) {
+				m.On("UpdateUser", mock.Anything, "user-999", "ghost").Return(usersrv.User{}, usersrv.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  string(models.NOTFOUND),
+			validateBody:   nil,
+		},
+		{
+			name:        "internal server error",
+			requestBody: UpdateUserRequest{UserID: "user-001", Username: "alice.smith"},
+			setupMock: func(m *mockService) {
+				m.On("UpdateUser", mock.Anything, "user-001", "alice.smith").Return(usersrv.User{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "INTERNAL_ERROR",
+			validateBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mockService)
+			tt.setupMock(mockSvc)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+			handler := &Handler{
+				service: mockSvc,
+				logger:  logger,
+			}
+
+			router := gin.New()
+			router.Use(api.ErrorMiddleware(logger))
+			apitest.RegisterHandlers(router, handler)
+
+			var bodyBytes []byte
+			var err error
+
+			if str, ok := tt.requestBody.(string); ok {
+				bodyBytes = []byte(str)
+			} else {
+				bodyBytes, err = json.Marshal(tt.requestBody)
+				assert.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "/users/update", bytes.NewBuffer(bodyBytes))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.validateBody != nil {
+				tt.validateBody(t, w)
+			}
+
+			if tt.expectedError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedError)
+			}
+
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
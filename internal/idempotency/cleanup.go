@@ -0,0 +1,30 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RunCleanup удаляет истекшие ключи раз в interval до отмены ctx
+func RunCleanup(ctx context.Context, r Repo, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := r.DeleteExpired(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Failed to delete expired idempotency keys")
+				continue
+			}
+			if deleted > 0 {
+				logger.WithField("deleted", deleted).Debug("Deleted expired idempotency keys")
+			}
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	repo "github.com/aabbuukkaarr8/PRService/internal/repository/idempotency"
+)
+
+type Repo interface {
+	Reserve(ctx context.Context, scope repo.Scope, requestHash string, lease time.Duration) (bool, error)
+	Get(ctx context.Context, scope repo.Scope) (repo.Record, error)
+	Complete(ctx context.Context, scope repo.Scope, statusCode int, contentType string, body []byte, ttl time.Duration) error
+	Release(ctx context.Context, scope repo.Scope) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,180 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	repo "github.com/aabbuukkaarr8/PRService/internal/repository/idempotency"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// HeaderKey заголовок запроса с ключом идемпотентности
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed выставляется в ответе, повторенном из сохраненного
+	HeaderReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	// maxBodySize ограничение тела, читаемого для хеша: не меньше самого большого лимита хендлеров (импорт ростера)
+	maxBodySize = 10 << 20
+)
+
+// Middleware делает POST-запросы с заголовком Idempotency-Key идемпотентными.
+// Первый ответ сохраняется на ttl и возвращается на повторы с тем же ключом и телом;
+// повтор с другой строкой запроса получает 422, с другим телом или во время выполнения первого запроса - 409.
+// Выполняющийся запрос держит ключ не дольше lease, поэтому ключ процесса, упавшего посреди
+// запроса, освобождается сам. Ответы 5xx и паники не сохраняются, чтобы повтор выполнился заново
+func Middleware(r Repo, ttl, lease time.Duration, logger *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderKey)
+		// у запросов без маршрута нет операции, общий пустой scope смешал бы их ключи
+		if c.Request.Method != http.MethodPost || key == "" || c.FullPath() == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxKeyLength {
+			abort(c, http.StatusBadRequest, "INVALID_REQUEST", "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize))
+		if err != nil {
+			abort(c, http.StatusBadRequest, "INVALID_REQUEST", "failed to read request body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestHash := hashRequest(c.Request.URL.RawQuery, body)
+		// путь операции общий у /api/v1 и устаревшего псевдонима: смена пути между повторами не выполнит запрос дважды
+		scope := repo.Scope{Key: key, Method: c.Request.Method, Path: api.Operation(c)}
+		log := logging.FromContextOr(c.Request.Context(), logger).WithField("idempotency_key", key).WithField("path", scope.Path)
+
+		// ключ может освободиться между Reserve и Get, тогда пробуем занять его еще раз
+		for attempt := 0; attempt < 2; attempt++ {
+			reserved, err := r.Reserve(c.Request.Context(), scope, requestHash, lease)
+			if err != nil {
+				log.WithError(err).Error("Failed to reserve idempotency key")
+				api.SendInternalError(c)
+				c.Abort()
+				return
+			}
+			if reserved {
+				execute(c, r, scope, ttl, log)
+				return
+			}
+
+			record, err := r.Get(c.Request.Context(), scope)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				log.WithError(err).Error("Failed to get idempotency key")
+				api.SendInternalError(c)
+				c.Abort()
+				return
+			}
+
+			switch {
+			case !sameQuery(record.RequestHash, requestHash):
+				abort(c, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_MISMATCH", "Idempotency-Key was already used with a different query string")
+			case record.RequestHash != requestHash:
+				abort(c, http.StatusConflict, "IDEMPOTENCY_KEY_MISMATCH", "Idempotency-Key was already used with a different request body")
+			case !record.Completed:
+				abort(c, http.StatusConflict, "IDEMPOTENCY_KEY_IN_PROGRESS", "request with this Idempotency-Key is still in progress")
+			default:
+				c.Header(HeaderReplayed, "true")
+				c.Data(record.StatusCode, record.ContentType, record.Body)
+				c.Abort()
+			}
+			return
+		}
+
+		abort(c, http.StatusConflict, "IDEMPOTENCY_KEY_IN_PROGRESS", "request with this Idempotency-Key is still in progress")
+	}
+}
+
+// hashRequest хеш запроса из хешей строки запроса и тела, разделенных ':'.
+// Строка запроса входит в хеш: dry_run и другие параметры меняют результат операции
+func hashRequest(query string, body []byte) string {
+	querySum := sha256.Sum256([]byte(query))
+	bodySum := sha256.Sum256(body)
+	return hex.EncodeToString(querySum[:]) + ":" + hex.EncodeToString(bodySum[:])
+}
+
+// sameQuery сравнивает части хешей, относящиеся к строке запроса
+func sameQuery(a, b string) bool {
+	queryA, _, _ := strings.Cut(a, ":")
+	queryB, _, _ := strings.Cut(b, ":")
+	return queryA == queryB
+}
+
+// execute выполняет запрос, занявший ключ, и сохраняет ответ.
+// Сохранение не зависит от отмены запроса: клиент, не дождавшийся ответа, получит его при повторе.
+// Паника хендлера освобождает ключ и передается дальше, в logging.Recovery
+func execute(c *gin.Context, r Repo, scope repo.Scope, ttl time.Duration, log *logrus.Entry) {
+	ctx := context.WithoutCancel(c.Request.Context())
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+
+	defer func() {
+		if p := recover(); p != nil {
+			release(ctx, r, scope, log)
+			panic(p)
+		}
+	}()
+
+	c.Next()
+
+	status := recorder.Status()
+	if status >= http.StatusInternalServerError {
+		release(ctx, r, scope, log)
+		return
+	}
+
+	if err := r.Complete(ctx, scope, status, recorder.Header().Get("Content-Type"), recorder.body.Bytes(), ttl); err != nil {
+		log.WithError(err).Error("Failed to save idempotent response")
+	}
+}
+
+func release(ctx context.Context, r Repo, scope repo.Scope, log *logrus.Entry) {
+	if err := r.Release(ctx, scope); err != nil {
+		log.WithError(err).Error("Failed to release idempotency key")
+	}
+}
+
+func abort(c *gin.Context, status int, code models.ErrorResponseErrorCode, message string) {
+	api.SendError(c, status, api.Error{
+		Code:    code,
+		Message: message,
+	})
+	c.Abort()
+}
+
+// responseRecorder копирует тело ответа, чтобы сохранить его для повторов
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,391 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	repo "github.com/aabbuukkaarr8/PRService/internal/repository/idempotency"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+func (m *mockRepo) Reserve(ctx context.Context, scope repo.Scope, requestHash string, lease time.Duration) (bool, error) {
+	args := m.Called(ctx, scope, requestHash, lease)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockRepo) Get(ctx context.Context, scope repo.Scope) (repo.Record, error) {
+	args := m.Called(ctx, scope)
+	return args.Get(0).(repo.Record), args.Error(1)
+}
+
+func (m *mockRepo) Complete(ctx context.Context, scope repo.Scope, statusCode int, contentType string, body []byte, ttl time.Duration) error {
+	args := m.Called(ctx, scope, statusCode, contentType, body, ttl)
+	return args.Error(0)
+}
+
+func (m *mockRepo) Release(ctx context.Context, scope repo.Scope) error {
+	args := m.Called(ctx, scope)
+	return args.Error(0)
+}
+
+func (m *mockRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func hashOf(query, body string) string {
+	querySum := sha256.Sum256([]byte(query))
+	bodySum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(querySum[:]) + ":" + hex.EncodeToString(bodySum[:])
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const body = `{"pull_request_id":"pr-001"}`
+	scope := repo.Scope{Key: "key-1", Method: http.MethodPost, Path: "/pullRequest/reassign"}
+
+	tests := []struct {
+		name            string
+		method          string
+		target          string
+		key             string
+		body            string
+		handlerStatus   int
+		setupMock       func(*mockRepo)
+		expectedStatus  int
+		expectedBody    string
+		expectedReplay  bool
+		expectedHandled bool
+	}{
+		{
+			name:            "no key passes through",
+			method:          http.MethodPost,
+			body:            body,
+			handlerStatus:   http.StatusOK,
+			setupMock:       func(m *mockRepo) {},
+			expectedStatus:  http.StatusOK,
+			expectedBody:    `{"handled":true}`,
+			expectedHandled: true,
+		},
+		{
+			name:            "GET is not affected",
+			method:          http.MethodGet,
+			key:             "key-1",
+			handlerStatus:   http.StatusOK,
+			setupMock:       func(m *mockRepo) {},
+			expectedStatus:  http.StatusOK,
+			expectedHandled: true,
+		},
+		{
+			name:          "first request is executed and saved",
+			method:        http.MethodPost,
+			key:           "key-1",
+			body:          body,
+			handlerStatus: http.StatusOK,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(true, nil)
+				m.On("Complete", mock.Anything, scope, http.StatusOK, "application/json; charset=utf-8", []byte(`{"handled":true}`), time.Hour).Return(nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedBody:    `{"handled":true}`,
+			expectedHandled: true,
+		},
+		{
+			name:          "server error releases the key",
+			method:        http.MethodPost,
+			key:           "key-1",
+			body:          body,
+			handlerStatus: http.StatusInternalServerError,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(true, nil)
+				m.On("Release", mock.Anything, scope).Return(nil)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedHandled: true,
+		},
+		{
+			name:   "retry replays saved response",
+			method: http.MethodPost,
+			key:    "key-1",
+			body:   body,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(false, nil)
+				m.On("Get", mock.Anything, scope).Return(repo.Record{
+					RequestHash: hashOf("", body),
+					Completed:   true,
+					StatusCode:  http.StatusCreated,
+					ContentType: "application/json",
+					Body:        []byte(`{"saved":true}`),
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"saved":true}`,
+			expectedReplay: true,
+		},
+		{
+			name:   "retry with another body",
+			method: http.MethodPost,
+			key:    "key-1",
+			body:   `{"pull_request_id":"pr-002"}`,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, mock.Anything, time.Minute).Return(false, nil)
+				m.On("Get", mock.Anything, scope).Return(repo.Record{RequestHash: hashOf("", body), Completed: true}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "IDEMPOTENCY_KEY_MISMATCH",
+		},
+		{
+			name:   "retry with another query",
+			method: http.MethodPost,
+			target: "/pullRequest/reassign?dry_run=true",
+			key:    "key-1",
+			body:   body,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("dry_run=true", body), time.Minute).Return(false, nil)
+				m.On("Get", mock.Anything, scope).Return(repo.Record{RequestHash: hashOf("", body), Completed: true}, nil)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   "IDEMPOTENCY_KEY_MISMATCH",
+		},
+		{
+			name:   "retry while first request is in progress",
+			method: http.MethodPost,
+			key:    "key-1",
+			body:   body,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(false, nil)
+				m.On("Get", mock.Anything, scope).Return(repo.Record{RequestHash: hashOf("", body)}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "IDEMPOTENCY_KEY_IN_PROGRESS",
+		},
+		{
+			name:          "key released between reserve and get",
+			method:        http.MethodPost,
+			key:           "key-1",
+			body:          body,
+			handlerStatus: http.StatusOK,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(false, nil).Once()
+				m.On("Get", mock.Anything, scope).Return(repo.Record{}, sql.ErrNoRows).Once()
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(true, nil).Once()
+				m.On("Complete", mock.Anything, scope, http.StatusOK, mock.Anything, mock.Anything, time.Hour).Return(nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHandled: true,
+		},
+		{
+			name:           "key too long",
+			method:         http.MethodPost,
+			key:            strings.Repeat("k", maxKeyLength+1),
+			body:           body,
+			setupMock:      func(m *mockRepo) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_REQUEST",
+		},
+		{
+			name:           "body too large",
+			method:         http.MethodPost,
+			key:            "key-1",
+			body:           strings.Repeat("b", maxBodySize+1),
+			setupMock:      func(m *mockRepo) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_REQUEST",
+		},
+		{
+			name:            "unknown route is not affected",
+			method:          http.MethodPost,
+			target:          "/unknown",
+			key:             "key-1",
+			body:            body,
+			setupMock:       func(m *mockRepo) {},
+			expectedStatus:  http.StatusNotFound,
+			expectedHandled: false,
+		},
+		{
+			name:   "storage error",
+			method: http.MethodPost,
+			key:    "key-1",
+			body:   body,
+			setupMock: func(m *mockRepo) {
+				m.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(false, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.ErrorLevel)
+
+			handled := false
+			handler := func(c *gin.Context) {
+				handled = true
+				received, _ := c.GetRawData()
+				assert.Equal(t, tt.body, string(received))
+				c.JSON(tt.handlerStatus, gin.H{"handled": true})
+			}
+
+			router := gin.New()
+			router.Use(Middleware(mockRepo, time.Hour, time.Minute, logger))
+			router.POST("/pullRequest/reassign", handler)
+			router.GET("/pullRequest/reassign", handler)
+
+			target := tt.target
+			if target == "" {
+				target = "/pullRequest/reassign"
+			}
+			req, err := http.NewRequest(tt.method, target, strings.NewReader(tt.body))
+			assert.NoError(t, err)
+			if tt.key != "" {
+				req.Header.Set(HeaderKey, tt.key)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedHandled, handled)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			if tt.expectedReplay {
+				assert.Equal(t, "true", w.Header().Get(HeaderReplayed))
+			} else {
+				assert.Empty(t, w.Header().Get(HeaderReplayed))
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMiddleware_VersionedPathSharesScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const body = `{"pull_request_id":"pr-001"}`
+	scope := repo.Scope{Key: "key-1", Method: http.MethodPost, Path: "/pullRequest/reassign"}
+
+	mockRepo := new(mockRepo)
+	mockRepo.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(false, nil)
+	mockRepo.On("Get", mock.Anything, scope).Return(repo.Record{
+		RequestHash: hashOf("", body),
+		Completed:   true,
+		StatusCode:  http.StatusOK,
+		ContentType: "application/json",
+		Body:        []byte(`{"saved":true}`),
+	}, nil)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+
+	router := gin.New()
+	router.Use(Middleware(mockRepo, time.Hour, time.Minute, logger))
+	router.POST("/api/v1/pullRequest/reassign", func(c *gin.Context) {
+		t.Error("handler must not be called on replay")
+	})
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v1/pullRequest/reassign", strings.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set(HeaderKey, "key-1")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"saved":true}`, w.Body.String())
+	assert.Equal(t, "true", w.Header().Get(HeaderReplayed))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMiddleware_HandlerPanic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const body = `{"pull_request_id":"pr-001"}`
+	scope := repo.Scope{Key: "key-1", Method: http.MethodPost, Path: "/pullRequest/reassign"}
+
+	mockRepo := new(mockRepo)
+	mockRepo.On("Reserve", mock.Anything, scope, hashOf("", body), time.Minute).Return(true, nil)
+	mockRepo.On("Release", mock.Anything, scope).Return(nil)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+
+	recovered := false
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		defer func() {
+			if p := recover(); p != nil {
+				recovered = true
+				c.AbortWithStatus(http.StatusInternalServerError)
+			}
+		}()
+		c.Next()
+	})
+	router.Use(Middleware(mockRepo, time.Hour, time.Minute, logger))
+	router.POST("/pullRequest/reassign", func(c *gin.Context) {
+		panic("handler failed")
+	})
+
+	req, err := http.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set(HeaderKey, "key-1")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.True(t, recovered, "panic must reach outer recovery")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunCleanup(t *testing.T) {
+	called := make(chan struct{}, 1)
+	mockRepo := new(mockRepo)
+	mockRepo.On("DeleteExpired", mock.Anything).Return(int64(2), nil).Run(func(mock.Arguments) {
+		select {
+		case called <- struct{}{}:
+		default:
+		}
+	})
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		RunCleanup(ctx, mockRepo, time.Millisecond, logger)
+		close(done)
+	}()
+
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("expired keys were not deleted")
+	}
+	cancel()
+	<-done
+
+	mockRepo.AssertExpectations(t)
+}
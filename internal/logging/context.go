@@ -0,0 +1,39 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+type ctxKey int
+
+const (
+	loggerKey ctxKey = iota
+	requestIDKey
+)
+
+// WithLogger кладет логгер в контекст; репозитории и сервисы достают его через FromContext
+func WithLogger(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, loggerKey, entry)
+}
+
+// FromContext возвращает логгер из контекста (для запроса - с request_id),
+// а если его нет - стандартный логгер logrus
+func FromContext(ctx context.Context) *logrus.Entry {
+	return FromContextOr(ctx, logrus.StandardLogger())
+}
+
+// FromContextOr возвращает логгер из контекста или fallback, если его нет
+func FromContextOr(ctx context.Context, fallback *logrus.Logger) *logrus.Entry {
+	if entry, ok := ctx.Value(loggerKey).(*logrus.Entry); ok {
+		return entry
+	}
+	return logrus.NewEntry(fallback)
+}
+
+// RequestID возвращает идентификатор текущего запроса или пустую строку вне запроса
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HeaderRequestID заголовок с идентификатором запроса: принимается от клиента или прокси
+// и всегда возвращается в ответе
+const HeaderRequestID = "X-Request-ID"
+
+// validRequestID ограничивает входящие идентификаторы, чтобы в логи не попадал произвольный текст
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// Middleware назначает запросу X-Request-ID (или берет корректный из заголовка), кладет в контекст
+// логгер с request_id (и trace_id, если запрос трассируется) и после обработки пишет строку access-лога с маршрутом, статусом и длительностью
+func Middleware(logger *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(HeaderRequestID)
+		if !validRequestID.MatchString(requestID) {
+			requestID = newRequestID()
+		}
+		c.Header(HeaderRequestID, requestID)
+
+		entry := logger.WithField("request_id", requestID)
+		if sc := trace.SpanContextFromContext(c.Request.Context()); sc.IsValid() {
+			entry = entry.WithField("trace_id", sc.TraceID().String())
+		}
+		ctx := context.WithValue(c.Request.Context(), requestIDKey, requestID)
+		c.Request = c.Request.WithContext(WithLogger(ctx, entry))
+
+		c.Next()
+
+		status := c.Writer.Status()
+		access := entry.WithFields(logrus.Fields{
+			"method":     c.Request.Method,
+			"path":       c.Request.URL.Path,
+			"route":      c.FullPath(),
+			"status":     status,
+			"latency_ms": float64(time.Since(start).Microseconds()) / 1000,
+			"client_ip":  c.ClientIP(),
+			"bytes":      c.Writer.Size(),
+		})
+		if len(c.Errors) > 0 {
+			access = access.WithField("errors", c.Errors.String())
+		}
+
+		switch {
+		case status >= http.StatusInternalServerError:
+			access.Error("HTTP request")
+		case status >= http.StatusBadRequest:
+			access.Warn("HTTP request")
+		default:
+			access.Info("HTTP request")
+		}
+	}
+}
+
+// Recovery перехватывает панику в обработчике, логирует ее со стеком через логгер запроса и отвечает 500
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, err any) {
+		FromContext(c.Request.Context()).
+			WithField("panic", err).
+			WithField("stack", string(debug.Stack())).
+			Error("Panic while handling request")
+		c.AbortWithStatus(http.StatusInternalServerError)
+	})
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
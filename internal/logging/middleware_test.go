@@ -0,0 +1,125 @@
+package logging
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestID      string
+		path           string
+		expectedStatus int
+		expectedLevel  logrus.Level
+		keepRequestID  bool
+	}{
+		{
+			name:           "request id is propagated",
+			requestID:      "ci-run-42",
+			path:           "/items/7",
+			expectedStatus: http.StatusOK,
+			expectedLevel:  logrus.InfoLevel,
+			keepRequestID:  true,
+		},
+		{
+			name:           "request id is generated",
+			path:           "/items/7",
+			expectedStatus: http.StatusOK,
+			expectedLevel:  logrus.InfoLevel,
+		},
+		{
+			name:           "invalid request id is replaced",
+			requestID:      "bad id\twith spaces",
+			path:           "/items/7",
+			expectedStatus: http.StatusOK,
+			expectedLevel:  logrus.InfoLevel,
+		},
+		{
+			name:           "client error is logged as warning",
+			path:           "/missing",
+			expectedStatus: http.StatusNotFound,
+			expectedLevel:  logrus.WarnLevel,
+		},
+		{
+			name:           "panic is recovered and logged as error",
+			path:           "/panic",
+			expectedStatus: http.StatusInternalServerError,
+			expectedLevel:  logrus.ErrorLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, hook := test.NewNullLogger()
+
+			var handlerRequestID string
+			router := gin.New()
+			router.Use(Middleware(logger), Recovery())
+			router.GET("/items/:id", func(c *gin.Context) {
+				handlerRequestID = RequestID(c.Request.Context())
+				FromContext(c.Request.Context()).Info("handling")
+				c.Status(http.StatusOK)
+			})
+			router.GET("/panic", func(c *gin.Context) {
+				panic("boom")
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.requestID != "" {
+				req.Header.Set(HeaderRequestID, tt.requestID)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			requestID := w.Header().Get(HeaderRequestID)
+			require.NotEmpty(t, requestID)
+			if tt.keepRequestID {
+				assert.Equal(t, tt.requestID, requestID)
+			} else {
+				assert.NotEqual(t, tt.requestID, requestID)
+				assert.Len(t, requestID, 32)
+			}
+			if handlerRequestID != "" {
+				assert.Equal(t, requestID, handlerRequestID)
+			}
+
+			for _, entry := range hook.AllEntries() {
+				assert.Equal(t, requestID, entry.Data["request_id"])
+			}
+
+			access := hook.LastEntry()
+			require.NotNil(t, access)
+			assert.Equal(t, "HTTP request", access.Message)
+			assert.Equal(t, tt.expectedLevel, access.Level)
+			assert.Equal(t, tt.expectedStatus, access.Data["status"])
+			assert.Equal(t, tt.path, access.Data["path"])
+			assert.Contains(t, access.Data, "latency_ms")
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+
+	ctx := WithLogger(t.Context(), logger.WithField("request_id", "r1"))
+	FromContext(ctx).Info("inside request")
+	require.Len(t, hook.Entries, 1)
+	assert.Equal(t, "r1", hook.LastEntry().Data["request_id"])
+
+	fallback, fallbackHook := test.NewNullLogger()
+	FromContextOr(t.Context(), fallback).Info("outside request")
+	require.Len(t, fallbackHook.Entries, 1)
+	assert.Empty(t, RequestID(t.Context()))
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+)
+
+// Limit параметры корзины токенов: Rate запросов в секунду в среднем и не больше Burst подряд
+type Limit struct {
+	Rate  float64 `toml:"rate"`
+	Burst int     `toml:"burst"`
+}
+
+type Config struct {
+	Enabled bool  `toml:"enabled"`
+	Default Limit `toml:"default"`
+	// Routes лимиты отдельных маршрутов по ключу "METHOD /path", например "POST /pullRequest/create"
+	Routes map[string]Limit `toml:"routes"`
+	// CleanupInterval как часто из памяти удаляются заполнившиеся корзины
+	CleanupInterval time.Duration `toml:"cleanup_interval"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Enabled: true,
+		Default: Limit{Rate: 20, Burst: 40},
+		Routes: map[string]Limit{
+			"POST /pullRequest/create":  {Rate: 2, Burst: 10},
+			"POST /team/bulkDeactivate": {Rate: 0.1, Burst: 2},
+		},
+		CleanupInterval: time.Minute,
+	}
+}
+
+// Validate проверяет, что у всех лимитов положительные rate и burst
+func (c *Config) Validate() error {
+	if err := c.Default.validate(); err != nil {
+		return fmt.Errorf("ratelimit: default: %w", err)
+	}
+	for route, limit := range c.Routes {
+		if err := limit.validate(); err != nil {
+			return fmt.Errorf("ratelimit: route %q: %w", route, err)
+		}
+	}
+	if c.CleanupInterval <= 0 {
+		return fmt.Errorf("ratelimit: cleanup_interval must be positive")
+	}
+	return nil
+}
+
+func (l Limit) validate() error {
+	if l.Rate <= 0 || l.Burst <= 0 {
+		return fmt.Errorf("rate and burst must be positive")
+	}
+	return nil
+}
+
+// For лимит маршрута или лимит по умолчанию
+func (c *Config) For(method, path string) Limit {
+	if limit, ok := c.Routes[method+" "+path]; ok {
+		return limit
+	}
+	return c.Default
+}
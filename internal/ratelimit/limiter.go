@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter хранит корзины токенов. По умолчанию используется MemoryLimiter в памяти процесса;
+// для нескольких реплик сервиса достаточно реализовать интерфейс поверх общего хранилища
+type Limiter interface {
+	// Allow забирает токен из корзины key, если он есть
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Result состояние корзины после запроса
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// Reset через сколько корзина заполнится полностью
+	Reset time.Duration
+	// RetryAfter через сколько появится токен, если запрос не пропущен
+	RetryAfter time.Duration
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   Limit
+}
+
+// MemoryLimiter корзины токенов в памяти процесса
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok || b.limit != limit {
+		b = &bucket{tokens: float64(limit.Burst), updated: now, limit: limit}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+
+	result := Result{Allowed: b.tokens >= 1}
+	if result.Allowed {
+		b.tokens--
+	} else {
+		result.RetryAfter = seconds((1 - b.tokens) / limit.Rate)
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = seconds((float64(limit.Burst) - b.tokens) / limit.Rate)
+	return result, nil
+}
+
+// Cleanup удаляет заполнившиеся корзины: для них Allow вернет то же, что для новых
+func (l *MemoryLimiter) Cleanup() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	deleted := 0
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// RunCleanup вызывает Cleanup раз в interval до отмены ctx
+func (l *MemoryLimiter) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.Cleanup()
+		}
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.updated = now
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
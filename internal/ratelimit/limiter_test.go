@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	limit := Limit{Rate: 2, Burst: 3}
+
+	type call struct {
+		after    time.Duration
+		key      string
+		expected Result
+	}
+
+	tests := []struct {
+		name  string
+		calls []call
+	}{
+		{
+			name: "burst then rejected",
+			calls: []call{
+				{key: "a", expected: Result{Allowed: true, Remaining: 2, Reset: 500 * time.Millisecond}},
+				{key: "a", expected: Result{Allowed: true, Remaining: 1, Reset: time.Second}},
+				{key: "a", expected: Result{Allowed: true, Remaining: 0, Reset: 1500 * time.Millisecond}},
+				{key: "a", expected: Result{Allowed: false, Remaining: 0, Reset: 1500 * time.Millisecond, RetryAfter: 500 * time.Millisecond}},
+			},
+		},
+		{
+			name: "tokens are refilled over time",
+			calls: []call{
+				{key: "a", expected: Result{Allowed: true, Remaining: 2, Reset: 500 * time.Millisecond}},
+				{key: "a", expected: Result{Allowed: true, Remaining: 1, Reset: time.Second}},
+				{key: "a", expected: Result{Allowed: true, Remaining: 0, Reset: 1500 * time.Millisecond}},
+				{after: 250 * time.Millisecond, key: "a", expected: Result{Allowed: false, Remaining: 0, Reset: 1250 * time.Millisecond, RetryAfter: 250 * time.Millisecond}},
+				{after: 250 * time.Millisecond, key: "a", expected: Result{Allowed: true, Remaining: 0, Reset: 1500 * time.Millisecond}},
+				{after: time.Hour, key: "a", expected: Result{Allowed: true, Remaining: 2, Reset: 500 * time.Millisecond}},
+			},
+		},
+		{
+			name: "keys are independent",
+			calls: []call{
+				{key: "a", expected: Result{Allowed: true, Remaining: 2, Reset: 500 * time.Millisecond}},
+				{key: "a", expected: Result{Allowed: true, Remaining: 1, Reset: time.Second}},
+				{key: "b", expected: Result{Allowed: true, Remaining: 2, Reset: 500 * time.Millisecond}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			l := NewMemoryLimiter()
+			l.now = func() time.Time { return now }
+
+			for i, c := range tt.calls {
+				now = now.Add(c.after)
+				result, err := l.Allow(context.Background(), c.key, limit)
+				require.NoError(t, err)
+				assert.Equal(t, c.expected, result, "call %d", i)
+			}
+		})
+	}
+}
+
+func TestMemoryLimiter_ChangedLimit(t *testing.T) {
+	l := NewMemoryLimiter()
+
+	result, err := l.Allow(context.Background(), "a", Limit{Rate: 1, Burst: 1})
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	result, err = l.Allow(context.Background(), "a", Limit{Rate: 1, Burst: 5})
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 4, result.Remaining)
+}
+
+func TestMemoryLimiter_Cleanup(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewMemoryLimiter()
+	l.now = func() time.Time { return now }
+
+	_, err := l.Allow(context.Background(), "slow", Limit{Rate: 1, Burst: 10})
+	require.NoError(t, err)
+	_, err = l.Allow(context.Background(), "fast", Limit{Rate: 10, Burst: 10})
+	require.NoError(t, err)
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, 1, l.Cleanup())
+	assert.Contains(t, l.buckets, "slow")
+	assert.NotContains(t, l.buckets, "fast")
+
+	now = now.Add(time.Second)
+	assert.Equal(t, 1, l.Cleanup())
+	assert.Empty(t, l.buckets)
+}
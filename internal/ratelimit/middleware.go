@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	HeaderLimit      = "RateLimit-Limit"
+	HeaderRemaining  = "RateLimit-Remaining"
+	HeaderReset      = "RateLimit-Reset"
+	HeaderRetryAfter = "Retry-After"
+)
+
+// Middleware ограничивает частоту запросов корзиной токенов на пару (маршрут, клиент).
+// Маршрут берется из спецификации (api.Operation), поэтому /api/v1 и устаревший псевдоним в корне
+// делят лимит и корзину. Клиент определяется по IP из c.ClientIP: X-Forwarded-For учитывается только
+// от доверенных прокси роутера. API-токены сервис не проверяет, поэтому в ключ они не входят.
+// Каждый ответ получает заголовки RateLimit-*, превышение лимита - 429 с Retry-After.
+// Ошибки Limiter логируются, запрос пропускается
+func Middleware(l Limiter, cfg *Config, logger *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.Request.Method + " " + api.Operation(c)
+		limit := cfg.For(c.Request.Method, api.Operation(c))
+
+		result, err := l.Allow(c.Request.Context(), route+" "+clientKey(c), limit)
+		if err != nil {
+			logging.FromContextOr(c.Request.Context(), logger).WithError(err).
+				WithField("route", route).Error("Failed to check rate limit")
+			c.Next()
+			return
+		}
+
+		c.Header(HeaderLimit, strconv.Itoa(limit.Burst))
+		c.Header(HeaderRemaining, strconv.Itoa(result.Remaining))
+		c.Header(HeaderReset, strconv.Itoa(ceilSeconds(result.Reset)))
+
+		if !result.Allowed {
+			retryAfter := ceilSeconds(result.RetryAfter)
+			c.Header(HeaderRetryAfter, strconv.Itoa(retryAfter))
+			api.SendError(c, http.StatusTooManyRequests, api.Error{
+				Code:    "RATE_LIMITED",
+				Message: fmt.Sprintf("too many requests, retry after %d s", retryAfter),
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// clientKey ключ клиента по его IP
+func clientKey(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,231 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockLimiter struct {
+	mock.Mock
+}
+
+func (m *mockLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	args := m.Called(ctx, key, limit)
+	return args.Get(0).(Result), args.Error(1)
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &Config{
+		Default: Limit{Rate: 10, Burst: 20},
+		Routes: map[string]Limit{
+			"POST /team/bulkDeactivate": {Rate: 0.1, Burst: 2},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		headers          map[string]string
+		setupMock        func(*mockLimiter)
+		expectedStatus   int
+		expectedHeaders  map[string]string
+		expectedHandled  bool
+		expectedContains string
+	}{
+		{
+			name:   "allowed with default limit by ip",
+			method: http.MethodGet,
+			path:   "/team/get",
+			setupMock: func(m *mockLimiter) {
+				m.On("Allow", mock.Anything, "GET /team/get ip:192.0.2.1", Limit{Rate: 10, Burst: 20}).
+					Return(Result{Allowed: true, Remaining: 19, Reset: 100 * time.Millisecond}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				HeaderLimit:     "20",
+				HeaderRemaining: "19",
+				HeaderReset:     "1",
+			},
+			expectedHandled: true,
+		},
+		{
+			name:   "route limit",
+			method: http.MethodPost,
+			path:   "/team/bulkDeactivate",
+			setupMock: func(m *mockLimiter) {
+				m.On("Allow", mock.Anything, "POST /team/bulkDeactivate ip:192.0.2.1", Limit{Rate: 0.1, Burst: 2}).
+					Return(Result{Allowed: true, Remaining: 1, Reset: 10 * time.Second}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				HeaderLimit:     "2",
+				HeaderRemaining: "1",
+				HeaderReset:     "10",
+			},
+			expectedHandled: true,
+		},
+		{
+			name:   "versioned route shares limit with legacy path",
+			method: http.MethodPost,
+			path:   "/api/v1/team/bulkDeactivate",
+			setupMock: func(m *mockLimiter) {
+				m.On("Allow", mock.Anything, "POST /team/bulkDeactivate ip:192.0.2.1", Limit{Rate: 0.1, Burst: 2}).
+					Return(Result{Allowed: true, Remaining: 1}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHandled: true,
+		},
+		{
+			name:    "api token and forwarded headers do not change client key",
+			method:  http.MethodGet,
+			path:    "/team/get",
+			headers: map[string]string{"X-API-Key": "secret", "Authorization": "Bearer secret", "X-Forwarded-For": "198.51.100.7"},
+			setupMock: func(m *mockLimiter) {
+				m.On("Allow", mock.Anything, "GET /team/get ip:192.0.2.1", Limit{Rate: 10, Burst: 20}).
+					Return(Result{Allowed: true, Remaining: 19}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHandled: true,
+		},
+		{
+			name:   "rejected",
+			method: http.MethodPost,
+			path:   "/team/bulkDeactivate",
+			setupMock: func(m *mockLimiter) {
+				m.On("Allow", mock.Anything, "POST /team/bulkDeactivate ip:192.0.2.1", Limit{Rate: 0.1, Burst: 2}).
+					Return(Result{Allowed: false, Remaining: 0, Reset: 19500 * time.Millisecond, RetryAfter: 9500 * time.Millisecond}, nil)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedHeaders: map[string]string{
+				HeaderLimit:      "2",
+				HeaderRemaining:  "0",
+				HeaderReset:      "20",
+				HeaderRetryAfter: "10",
+			},
+			expectedContains: `"code":"RATE_LIMITED"`,
+		},
+		{
+			name:   "limiter error lets request through",
+			method: http.MethodGet,
+			path:   "/team/get",
+			setupMock: func(m *mockLimiter) {
+				m.On("Allow", mock.Anything, mock.Anything, mock.Anything).
+					Return(Result{}, errors.New("backend unavailable"))
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHandled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := new(mockLimiter)
+			tt.setupMock(m)
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.PanicLevel)
+
+			handled := false
+			router := gin.New()
+			_ = router.SetTrustedProxies(nil)
+			router.Use(Middleware(m, cfg, logger))
+			handler := func(c *gin.Context) {
+				handled = true
+				c.Status(http.StatusOK)
+			}
+			router.GET("/team/get", handler)
+			router.POST("/team/bulkDeactivate", handler)
+			router.POST("/api/v1/team/bulkDeactivate", handler)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedHandled, handled)
+			for k, v := range tt.expectedHeaders {
+				assert.Equal(t, v, w.Header().Get(k), k)
+			}
+			if tt.expectedContains != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedContains)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMiddleware_MemoryLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &Config{Default: Limit{Rate: 1, Burst: 2}}
+	logger := logrus.New()
+
+	router := gin.New()
+	_ = router.SetTrustedProxies(nil)
+	router.Use(Middleware(NewMemoryLimiter(), cfg, logger))
+	router.POST("/pullRequest/create", func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+	send := func(remoteAddr string, headers ...string) int {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil)
+		req.RemoteAddr = remoteAddr
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusCreated, send("192.0.2.1:1"))
+	assert.Equal(t, http.StatusCreated, send("192.0.2.1:2"))
+	assert.Equal(t, http.StatusTooManyRequests, send("192.0.2.1:3"))
+	assert.Equal(t, http.StatusCreated, send("192.0.2.2:1"))
+
+	// новые токены и X-Forwarded-For от недоверенного клиента не дают новую корзину
+	assert.Equal(t, http.StatusTooManyRequests, send("192.0.2.1:4", "X-API-Key", "token-1"))
+	assert.Equal(t, http.StatusTooManyRequests, send("192.0.2.1:5", "Authorization", "Bearer token-2"))
+	assert.Equal(t, http.StatusTooManyRequests, send("192.0.2.1:6", "X-Forwarded-For", "198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, send("192.0.2.1:7", "X-Real-IP", "198.51.100.2"))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(*Config)
+		expectErr bool
+	}{
+		{name: "default config", modify: func(*Config) {}},
+		{name: "zero default rate", modify: func(c *Config) { c.Default.Rate = 0 }, expectErr: true},
+		{name: "zero route burst", modify: func(c *Config) { c.Routes["GET /team/get"] = Limit{Rate: 1} }, expectErr: true},
+		{name: "zero cleanup interval", modify: func(c *Config) { c.CleanupInterval = 0 }, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig()
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
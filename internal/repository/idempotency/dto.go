@@ -0,0 +1,17 @@
+package idempotency
+
+// Scope область действия ключа: один и тот же ключ на разных эндпоинтах независим
+type Scope struct {
+	Key    string
+	Method string
+	Path   string
+}
+
+// Record сохраненный запрос; Completed false - первый запрос с этим ключом еще выполняется
+type Record struct {
+	RequestHash string
+	Completed   bool
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
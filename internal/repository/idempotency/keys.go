@@ -0,0 +1,96 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Reserve занимает ключ под новый запрос на время lease; срок хранения ответа выставляет Complete.
+// Истекший ключ, в том числе брошенный упавшим запросом, занимается заново.
+// Возвращает false, если ключ уже занят действующей записью
+func (r *Repository) Reserve(ctx context.Context, scope Scope, requestHash string, lease time.Duration) (bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var reserved bool
+	err := r.store.GetConn().QueryRowContext(ctx,
+		`INSERT INTO idempotency_keys (idempotency_key, method, path, request_hash, expires_at)
+		 VALUES ($1, $2, $3, $4, NOW() + make_interval(secs => $5))
+		 ON CONFLICT (idempotency_key, method, path) DO UPDATE
+		 SET request_hash = EXCLUDED.request_hash,
+		     status_code = NULL,
+		     content_type = '',
+		     response_body = NULL,
+		     created_at = NOW(),
+		     expires_at = EXCLUDED.expires_at
+		 WHERE idempotency_keys.expires_at <= NOW()
+		 RETURNING TRUE`,
+		scope.Key, scope.Method, scope.Path, requestHash, lease.Seconds()).Scan(&reserved)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return reserved, nil
+}
+
+// Get возвращает действующую запись ключа; sql.ErrNoRows, если ее нет или она истекла
+func (r *Repository) Get(ctx context.Context, scope Scope) (Record, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var record Record
+	var statusCode sql.NullInt64
+	err := r.store.GetConn().QueryRowContext(ctx,
+		`SELECT request_hash, status_code, content_type, response_body
+		 FROM idempotency_keys
+		 WHERE idempotency_key = $1 AND method = $2 AND path = $3 AND expires_at > NOW()`,
+		scope.Key, scope.Method, scope.Path).Scan(&record.RequestHash, &statusCode, &record.ContentType, &record.Body)
+	if err != nil {
+		return Record{}, err
+	}
+
+	record.Completed = statusCode.Valid
+	record.StatusCode = int(statusCode.Int64)
+	return record, nil
+}
+
+// Complete сохраняет ответ на запрос, занявший ключ, на ttl
+func (r *Repository) Complete(ctx context.Context, scope Scope, statusCode int, contentType string, body []byte, ttl time.Duration) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.store.GetConn().ExecContext(ctx,
+		`UPDATE idempotency_keys
+		 SET status_code = $4, content_type = $5, response_body = $6,
+		     expires_at = NOW() + make_interval(secs => $7)
+		 WHERE idempotency_key = $1 AND method = $2 AND path = $3`,
+		scope.Key, scope.Method, scope.Path, statusCode, contentType, body, ttl.Seconds())
+	return err
+}
+
+// Release освобождает ключ, чтобы повтор запроса выполнился заново
+func (r *Repository) Release(ctx context.Context, scope Scope) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.store.GetConn().ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE idempotency_key = $1 AND method = $2 AND path = $3`,
+		scope.Key, scope.Method, scope.Path)
+	return err
+}
+
+// DeleteExpired удаляет истекшие ключи и возвращает их количество
+func (r *Repository) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.store.GetConn().ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,165 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+var testScope = Scope{Key: "key-1", Method: "POST", Path: "/pullRequest/create"}
+
+func newTestRepository(t *testing.T) (*Repository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store := store.New()
+	store.SetConn(db)
+
+	return NewRepository(store), mock
+}
+
+func TestRepository_Reserve(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name: "new key",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO idempotency_keys .+ON CONFLICT \(idempotency_key, method, path\) DO UPDATE.+WHERE idempotency_keys.expires_at <= NOW\(\)`).
+					WithArgs("key-1", "POST", "/pullRequest/create", "hash", float64(3600)).
+					WillReturnRows(sqlmock.NewRows([]string{"bool"}).AddRow(true))
+			},
+			expectedResult: true,
+		},
+		{
+			name: "key already taken",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO idempotency_keys`).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedResult: false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO idempotency_keys`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedError: errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestRepository(t)
+			tt.setupMock(mock)
+
+			reserved, err := repo.Reserve(context.Background(), testScope, "hash", time.Hour)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, reserved)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult Record
+		expectedError  error
+	}{
+		{
+			name: "completed request",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"request_hash", "status_code", "content_type", "response_body"}).
+					AddRow("hash", 201, "application/json", []byte(`{"ok":true}`))
+				mock.ExpectQuery(`SELECT request_hash, status_code, content_type, response_body\s+FROM idempotency_keys\s+WHERE .+expires_at > NOW\(\)`).
+					WithArgs("key-1", "POST", "/pullRequest/create").
+					WillReturnRows(rows)
+			},
+			expectedResult: Record{RequestHash: "hash", Completed: true, StatusCode: 201, ContentType: "application/json", Body: []byte(`{"ok":true}`)},
+		},
+		{
+			name: "request in progress",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"request_hash", "status_code", "content_type", "response_body"}).
+					AddRow("hash", nil, "", nil)
+				mock.ExpectQuery(`SELECT request_hash`).WillReturnRows(rows)
+			},
+			expectedResult: Record{RequestHash: "hash"},
+		},
+		{
+			name: "no record",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT request_hash`).WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestRepository(t)
+			tt.setupMock(mock)
+
+			record, err := repo.Get(context.Background(), testScope)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, record)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_CompleteAndRelease(t *testing.T) {
+	repo, mock := newTestRepository(t)
+
+	mock.ExpectExec(`UPDATE idempotency_keys\s+SET status_code = \$4, content_type = \$5, response_body = \$6,\s+expires_at = NOW\(\) \+ make_interval\(secs => \$7\)`).
+		WithArgs("key-1", "POST", "/pullRequest/create", 200, "application/json", []byte("{}"), float64(86400)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE idempotency_key = \$1`).
+		WithArgs("key-1", "POST", "/pullRequest/create").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Complete(context.Background(), testScope, 200, "application/json", []byte("{}"), 24*time.Hour))
+	assert.NoError(t, repo.Release(context.Background(), testScope))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteExpired(t *testing.T) {
+	repo, mock := newTestRepository(t)
+
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE expires_at <= NOW\(\)`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	deleted, err := repo.DeleteExpired(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,93 @@
+package owners
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
+)
+
+// CreateRule добавляет правило в конец списка
+func (r *Repository) CreateRule(ctx context.Context, rule OwnershipRule) (OwnershipRule, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.store.GetConn().BeginTx(ctx, nil)
+	if err != nil {
+		return OwnershipRule{}, err
+	}
+	defer tx.Rollback()
+
+	rule.RuleID, err = insertRule(ctx, tx, rule)
+	if err != nil {
+		return OwnershipRule{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return OwnershipRule{}, err
+	}
+	return rule, nil
+}
+
+// ReplaceRules удаляет все правила и сохраняет rules в заданном порядке
+func (r *Repository) ReplaceRules(ctx context.Context, rules []OwnershipRule) ([]OwnershipRule, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.store.GetConn().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM ownership_rules"); err != nil {
+		return nil, err
+	}
+
+	saved := make([]OwnershipRule, len(rules))
+	for i, rule := range rules {
+		rule.RuleID, err = insertRule(ctx, tx, rule)
+		if err != nil {
+			return nil, err
+		}
+		saved[i] = rule
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func insertRule(ctx context.Context, tx *sql.Tx, rule OwnershipRule) (int64, error) {
+	var ruleID int64
+	err := tx.QueryRowContext(ctx,
+		"INSERT INTO ownership_rules (pattern) VALUES ($1) RETURNING rule_id",
+		rule.Pattern).Scan(&ruleID)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rule.UserIDs) > 0 {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO ownership_rule_users (rule_id, user_id)
+			 SELECT $1, user_id FROM unnest($2::text[]) AS user_id`,
+			ruleID, pq.Array(rule.UserIDs))
+		if err != nil {
+			return 0, store.TranslateDBErr(err)
+		}
+	}
+
+	if len(rule.TeamNames) > 0 {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO ownership_rule_teams (rule_id, team_name)
+			 SELECT $1, team_name FROM unnest($2::text[]) AS team_name`,
+			ruleID, pq.Array(rule.TeamNames))
+		if err != nil {
+			return 0, store.TranslateDBErr(err)
+		}
+	}
+
+	return ruleID, nil
+}
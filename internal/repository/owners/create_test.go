@@ -0,0 +1,139 @@
+package owners
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_CreateRule(t *testing.T) {
+	tests := []struct {
+		name           string
+		rule           OwnershipRule
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult OwnershipRule
+		expectedError  error
+	}{
+		{
+			name: "rule with users and teams",
+			rule: OwnershipRule{Pattern: "*.go", UserIDs: []string{"u1"}, TeamNames: []string{"backend"}},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO ownership_rules \(pattern\) VALUES \(\$1\) RETURNING rule_id`).
+					WithArgs("*.go").
+					WillReturnRows(sqlmock.NewRows([]string{"rule_id"}).AddRow(7))
+				mock.ExpectExec(`INSERT INTO ownership_rule_users \(rule_id, user_id\)`).
+					WithArgs(int64(7), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO ownership_rule_teams \(rule_id, team_name\)`).
+					WithArgs(int64(7), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedResult: OwnershipRule{RuleID: 7, Pattern: "*.go", UserIDs: []string{"u1"}, TeamNames: []string{"backend"}},
+			expectedError:  nil,
+		},
+		{
+			name: "rule without owners",
+			rule: OwnershipRule{Pattern: "/vendor/"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO ownership_rules`).
+					WithArgs("/vendor/").
+					WillReturnRows(sqlmock.NewRows([]string{"rule_id"}).AddRow(8))
+				mock.ExpectCommit()
+			},
+			expectedResult: OwnershipRule{RuleID: 8, Pattern: "/vendor/"},
+			expectedError:  nil,
+		},
+		{
+			name: "error inserting owners",
+			rule: OwnershipRule{Pattern: "*.go", UserIDs: []string{"u1"}},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO ownership_rules`).
+					WillReturnRows(sqlmock.NewRows([]string{"rule_id"}).AddRow(9))
+				mock.ExpectExec(`INSERT INTO ownership_rule_users`).
+					WillReturnError(errors.New("foreign key violation"))
+				mock.ExpectRollback()
+			},
+			expectedResult: OwnershipRule{},
+			expectedError:  errors.New("foreign key violation"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			rule, err := NewRepository(store).CreateRule(context.Background(), tt.rule)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResult, rule)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRepository_ReplaceRules(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM ownership_rules`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectQuery(`INSERT INTO ownership_rules`).
+		WithArgs("*").
+		WillReturnRows(sqlmock.NewRows([]string{"rule_id"}).AddRow(10))
+	mock.ExpectExec(`INSERT INTO ownership_rule_teams`).
+		WithArgs(int64(10), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO ownership_rules`).
+		WithArgs("/docs/").
+		WillReturnRows(sqlmock.NewRows([]string{"rule_id"}).AddRow(11))
+	mock.ExpectExec(`INSERT INTO ownership_rule_users`).
+		WithArgs(int64(11), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	store := store.New()
+	store.SetConn(db)
+
+	rules, err := NewRepository(store).ReplaceRules(context.Background(), []OwnershipRule{
+		{Pattern: "*", TeamNames: []string{"backend"}},
+		{Pattern: "/docs/", UserIDs: []string{"u1"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []OwnershipRule{
+		{RuleID: 10, Pattern: "*", TeamNames: []string{"backend"}},
+		{RuleID: 11, Pattern: "/docs/", UserIDs: []string{"u1"}},
+	}, rules)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,21 @@
+package owners
+
+import "context"
+
+// DeleteRule удаляет правило; false, если правила нет
+func (r *Repository) DeleteRule(ctx context.Context, ruleID int64) (bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	result, err := r.store.GetConn().ExecContext(ctx,
+		"DELETE FROM ownership_rules WHERE rule_id = $1", ruleID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
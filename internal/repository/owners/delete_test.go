@@ -0,0 +1,79 @@
+package owners
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_DeleteRule(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name: "rule deleted",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM ownership_rules WHERE rule_id = \$1`).
+					WithArgs(int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectedResult: true,
+			expectedError:  nil,
+		},
+		{
+			name: "rule not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM ownership_rules WHERE rule_id = \$1`).
+					WithArgs(int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedResult: false,
+			expectedError:  nil,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM ownership_rules`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: false,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			deleted, err := NewRepository(store).DeleteRule(context.Background(), 3)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResult, deleted)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
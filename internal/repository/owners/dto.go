@@ -0,0 +1,9 @@
+package owners
+
+// OwnershipRule правило владения кодом: шаблон пути и владельцы (пользователи и команды)
+type OwnershipRule struct {
+	RuleID    int64
+	Pattern   string
+	UserIDs   []string
+	TeamNames []string
+}
@@ -0,0 +1,43 @@
+package owners
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// GetExistingUsers возвращает ID существующих пользователей из userIDs
+func (r *Repository) GetExistingUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	return r.selectExisting(ctx, "SELECT user_id FROM users WHERE user_id = ANY($1)", userIDs)
+}
+
+// GetExistingTeams возвращает имена существующих команд из teamNames
+func (r *Repository) GetExistingTeams(ctx context.Context, teamNames []string) ([]string, error) {
+	return r.selectExisting(ctx, "SELECT team_name FROM teams WHERE team_name = ANY($1)", teamNames)
+}
+
+func (r *Repository) selectExisting(ctx context.Context, query string, keys []string) ([]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.store.GetConn().QueryContext(ctx, query, pq.Array(keys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var existing []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		existing = append(existing, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,42 @@
+package owners
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// ListRules возвращает правила в порядке применения (по rule_id)
+func (r *Repository) ListRules(ctx context.Context) ([]OwnershipRule, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT r.rule_id, r.pattern,
+			ARRAY(SELECT ru.user_id FROM ownership_rule_users ru WHERE ru.rule_id = r.rule_id ORDER BY ru.user_id),
+			ARRAY(SELECT rt.team_name FROM ownership_rule_teams rt WHERE rt.rule_id = r.rule_id ORDER BY rt.team_name)
+		 FROM ownership_rules r
+		 ORDER BY r.rule_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []OwnershipRule
+	for rows.Next() {
+		var rule OwnershipRule
+		var userIDs, teamNames pq.StringArray
+		if err := rows.Scan(&rule.RuleID, &rule.Pattern, &userIDs, &teamNames); err != nil {
+			return nil, err
+		}
+		rule.UserIDs = []string(userIDs)
+		rule.TeamNames = []string(teamNames)
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
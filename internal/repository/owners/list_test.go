@@ -0,0 +1,75 @@
+package owners
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ListRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []OwnershipRule
+		expectedError  error
+	}{
+		{
+			name: "successful list",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"rule_id", "pattern", "user_ids", "team_names"}).
+					AddRow(1, "*", "{}", "{backend}").
+					AddRow(2, "/docs/", "{u1,u2}", "{}")
+				mock.ExpectQuery(`SELECT r.rule_id, r.pattern,.+FROM ownership_rules r\s+ORDER BY r.rule_id`).
+					WillReturnRows(rows)
+			},
+			expectedResult: []OwnershipRule{
+				{RuleID: 1, Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}},
+				{RuleID: 2, Pattern: "/docs/", UserIDs: []string{"u1", "u2"}, TeamNames: []string{}},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT r.rule_id`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			rules, err := NewRepository(store).ListRules(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, rules)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, rules)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
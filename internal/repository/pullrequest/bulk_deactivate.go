@@ -3,20 +3,24 @@ package pullrequest
 import (
 	"context"
 
-	"github.com/sirupsen/logrus"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
 )
 
 func (r *Repository) BulkDeactivateTeamUsers(ctx context.Context, teamName string) ([]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET is_active = FALSE
-		WHERE team_name = $1 AND is_active = TRUE
+		WHERE user_id IN (SELECT user_id FROM team_members WHERE team_name = $1)
+		  AND is_active = TRUE
 		RETURNING user_id
 	`
 
 	rows, err := r.store.GetConn().QueryContext(ctx, query, teamName)
 	if err != nil {
-		logrus.WithError(err).WithField("team_name", teamName).Error("Database error: failed to bulk deactivate team users")
+		logging.FromContext(ctx).WithError(err).WithField("team_name", teamName).Error("Database error: failed to bulk deactivate team users")
 		return nil, err
 	}
 	defer rows.Close()
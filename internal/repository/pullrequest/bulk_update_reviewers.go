@@ -3,45 +3,62 @@ package pullrequest
 import (
 	"context"
 
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 	"github.com/lib/pq"
-	"github.com/sirupsen/logrus"
 )
 
 type PRReviewerUpdate struct {
 	PullRequestID     string
 	AssignedReviewers []string
+	// Version версия PR, на основе которой посчитаны ревьюверы
+	Version int64
 }
 
+// BulkUpdatePullRequestReviewers обновляет ревьюверов нескольких PR в одной транзакции.
+// Если хотя бы один PR изменили параллельно, транзакция откатывается и возвращается store.ErrConflict
+
 func (r *Repository) BulkUpdatePullRequestReviewers(ctx context.Context, updates []PRReviewerUpdate) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	if len(updates) == 0 {
 		return nil
 	}
 
 	tx, err := r.store.GetConn().BeginTx(ctx, nil)
 	if err != nil {
-		logrus.WithError(err).WithField("updates_count", len(updates)).Error("Database error: failed to begin transaction for bulk update")
+		logging.FromContext(ctx).WithError(err).WithField("updates_count", len(updates)).Error("Database error: failed to begin transaction for bulk update")
 		return err
 	}
 	defer tx.Rollback()
 
 	stmt, err := tx.PrepareContext(ctx,
-		`UPDATE pullrequests SET assigned_reviewers = $1 WHERE pull_request_id = $2`)
+		`UPDATE pullrequests SET assigned_reviewers = $1, version = version + 1
+		 WHERE pull_request_id = $2 AND version = $3`)
 	if err != nil {
-		logrus.WithError(err).Error("Database error: failed to prepare statement for bulk update")
+		logging.FromContext(ctx).WithError(err).Error("Database error: failed to prepare statement for bulk update")
 		return err
 	}
 	defer stmt.Close()
 
 	for _, update := range updates {
-		_, err := stmt.ExecContext(ctx, pq.Array(update.AssignedReviewers), update.PullRequestID)
+		result, err := stmt.ExecContext(ctx, pq.Array(update.AssignedReviewers), update.PullRequestID, update.Version)
+		if err != nil {
+			logging.FromContext(ctx).WithError(err).WithField("pull_request_id", update.PullRequestID).Error("Database error: failed to update PR reviewers")
+			return err
+		}
+		affected, err := result.RowsAffected()
 		if err != nil {
-			logrus.WithError(err).WithField("pull_request_id", update.PullRequestID).Error("Database error: failed to update PR reviewers")
 			return err
 		}
+		if affected == 0 {
+			return store.ErrConflict
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
-		logrus.WithError(err).WithField("updates_count", len(updates)).Error("Database error: failed to commit bulk update transaction")
+		logging.FromContext(ctx).WithError(err).WithField("updates_count", len(updates)).Error("Database error: failed to commit bulk update transaction")
 		return err
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 	"github.com/lib/pq"
 )
 
@@ -12,15 +13,19 @@ func (r *Repository) CreatePullRequest(
 	ctx context.Context,
 	req *CreatePullRequest,
 ) (PullRequest, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	now := time.Now()
 
 	_, err := r.store.GetConn().ExecContext(ctx,
-		`INSERT INTO pullrequests (pull_request_id, pull_request_name, author_id, status, assigned_reviewers, 
-"created_at") 
-		 VALUES ($1, $2, $3, $4, $5, $6)`,
-		req.PullRequestId, req.PullRequestName, req.AuthorId, string(req.Status), pq.Array(req.AssignedReviewers), now)
+		`INSERT INTO pullrequests (pull_request_id, pull_request_name, author_id, team_name, status, assigned_reviewers, 
+"files", "labels", "created_at") 
+		 VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, COALESCE($7::text[], '{}'), COALESCE($8::text[], '{}'), $9)`,
+		req.PullRequestId, req.PullRequestName, req.AuthorId, req.TeamName, string(req.Status), pq.Array(req.AssignedReviewers),
+		pq.Array(req.Files), pq.Array(req.Labels), now)
 	if err != nil {
-		return PullRequest{}, err
+		return PullRequest{}, store.TranslateDBErr(err)
 	}
 
 	// Возвращаем созданный PR
@@ -28,9 +33,13 @@ func (r *Repository) CreatePullRequest(
 		PullRequestID:     req.PullRequestId,
 		PullRequestName:   req.PullRequestName,
 		AuthorID:          req.AuthorId,
+		TeamName:          req.TeamName,
 		Status:            string(req.Status),
 		AssignedReviewers: req.AssignedReviewers,
+		Files:             req.Files,
+		Labels:            req.Labels,
 		CreatedAt:         &now,
 		MergedAt:          nil,
+		Version:           1,
 	}, nil
 }
@@ -25,18 +25,20 @@ func TestRepository_CreatePullRequest(t *testing.T) {
 				PullRequestId:     "pr-001",
 				PullRequestName:   "Test PR",
 				AuthorId:          "user-001",
+				TeamName:          "backend",
 				Status:            models.PullRequestStatusOPEN,
 				AssignedReviewers: []string{"user-002", "user-003"},
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec(`INSERT INTO pullrequests`).
-					WithArgs("pr-001", "Test PR", "user-001", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("pr-001", "Test PR", "user-001", "backend", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedResult: PullRequest{
 				PullRequestID:     "pr-001",
 				PullRequestName:   "Test PR",
 				AuthorID:          "user-001",
+				TeamName:          "backend",
 				Status:            "OPEN",
 				AssignedReviewers: []string{"user-002", "user-003"},
 			},
@@ -48,18 +50,20 @@ func TestRepository_CreatePullRequest(t *testing.T) {
 				PullRequestId:     "pr-002",
 				PullRequestName:   "Test PR 2",
 				AuthorId:          "user-001",
+				TeamName:          "backend",
 				Status:            models.PullRequestStatusOPEN,
 				AssignedReviewers: []string{},
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec(`INSERT INTO pullrequests`).
-					WithArgs("pr-002", "Test PR 2", "user-001", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("pr-002", "Test PR 2", "user-001", "backend", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedResult: PullRequest{
 				PullRequestID:     "pr-002",
 				PullRequestName:   "Test PR 2",
 				AuthorID:          "user-001",
+				TeamName:          "backend",
 				Status:            "OPEN",
 				AssignedReviewers: []string{},
 			},
@@ -71,12 +75,13 @@ func TestRepository_CreatePullRequest(t *testing.T) {
 				PullRequestId:     "pr-003",
 				PullRequestName:   "Test PR 3",
 				AuthorId:          "user-001",
+				TeamName:          "backend",
 				Status:            models.PullRequestStatusOPEN,
 				AssignedReviewers: []string{"user-002"},
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec(`INSERT INTO pullrequests`).
-					WithArgs("pr-003", "Test PR 3", "user-001", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("pr-003", "Test PR 3", "user-001", "backend", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(errors.New("database connection error"))
 			},
 			expectedResult: PullRequest{},
@@ -88,12 +93,13 @@ func TestRepository_CreatePullRequest(t *testing.T) {
 				PullRequestId:     "pr-004",
 				PullRequestName:   "Test PR 4",
 				AuthorId:          "user-001",
+				TeamName:          "backend",
 				Status:            models.PullRequestStatusOPEN,
 				AssignedReviewers: []string{"user-002"},
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec(`INSERT INTO pullrequests`).
-					WithArgs("pr-004", "Test PR 4", "user-001", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("pr-004", "Test PR 4", "user-001", "backend", "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(errors.New("duplicate key value violates unique constraint"))
 			},
 			expectedResult: PullRequest{},
@@ -10,16 +10,45 @@ type PullRequest struct {
 	PullRequestID     string
 	PullRequestName   string
 	AuthorID          string
+	TeamName          string
 	Status            string
 	AssignedReviewers []string
+	Files             []string
+	Labels            []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	Version           int64
 }
 
 type CreatePullRequest struct {
 	AuthorId          string
 	PullRequestId     string
 	PullRequestName   string
+	TeamName          string
 	Status            models.PullRequestStatus
 	AssignedReviewers []string
+	Files             []string
+	Labels            []string
+}
+
+// Поля сортировки списка PR
+const (
+	SortByCreatedAt = "created_at"
+	SortByID        = "pull_request_id"
+)
+
+// PullRequestListFilter фильтры, сортировка и курсор списка PR; пустые фильтры не применяются.
+// Курсор (AfterID и для сортировки по дате AfterCreatedAt) - ключ последнего PR предыдущей страницы
+type PullRequestListFilter struct {
+	AuthorID       string
+	ReviewerID     string
+	TeamName       string
+	Status         string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	SortBy         string
+	Desc           bool
+	AfterCreatedAt time.Time
+	AfterID        string
+	Limit          int
 }
@@ -4,6 +4,9 @@ import "context"
 
 // PRExists проверяет, существует ли PR
 func (r *Repository) PRExists(ctx context.Context, pullRequestID string) (bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var exists bool
 	err := r.store.GetConn().QueryRowContext(ctx,
 		"SELECT EXISTS(SELECT 1 FROM pullrequests WHERE pull_request_id = $1)",
@@ -13,3 +16,18 @@ func (r *Repository) PRExists(ctx context.Context, pullRequestID string) (bool,
 	}
 	return exists, nil
 }
+
+// TeamExists проверяет, существует ли команда
+func (r *Repository) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	err := r.store.GetConn().QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)",
+		teamName).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
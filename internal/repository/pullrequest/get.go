@@ -4,35 +4,63 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 	"github.com/lib/pq"
 )
 
-// GetPullRequest получает PR по ID
-func (r *Repository) GetPullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
+// pullRequestColumns колонки PR в порядке, который ожидает scanPullRequest
+const pullRequestColumns = `pull_request_id, pull_request_name, author_id, COALESCE(team_name, ''), status,
+	assigned_reviewers, files, labels, created_at, merged_at, version`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPullRequest(row rowScanner) (PullRequest, error) {
 	var pr PullRequest
-	var assignedReviewers pq.StringArray
+	var assignedReviewers, files, labels pq.StringArray
 
-	err := r.store.GetConn().QueryRowContext(ctx,
-		`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at 
-		 FROM pullrequests WHERE pull_request_id = $1`,
-		pullRequestID).Scan(
+	err := row.Scan(
 		&pr.PullRequestID,
 		&pr.PullRequestName,
 		&pr.AuthorID,
+		&pr.TeamName,
 		&pr.Status,
 		&assignedReviewers,
+		&files,
+		&labels,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&pr.Version,
 	)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return PullRequest{}, sql.ErrNoRows
-		}
 		return PullRequest{}, err
 	}
 
 	// Конвертируем pq.StringArray в []string
 	pr.AssignedReviewers = []string(assignedReviewers)
+	pr.Files = []string(files)
+	pr.Labels = []string(labels)
+	pr.CreatedAt = store.FromTimestamp(pr.CreatedAt)
+	pr.MergedAt = store.FromTimestamp(pr.MergedAt)
+
+	return pr, nil
+}
+
+// GetPullRequest получает PR по ID
+func (r *Repository) GetPullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	pr, err := scanPullRequest(r.store.GetConn().QueryRowContext(ctx,
+		`SELECT `+pullRequestColumns+` FROM pullrequests WHERE pull_request_id = $1`,
+		pullRequestID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PullRequest{}, sql.ErrNoRows
+		}
+		return PullRequest{}, err
+	}
 
 	return pr, nil
 }
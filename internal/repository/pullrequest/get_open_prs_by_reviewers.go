@@ -11,10 +11,14 @@ type OpenPRWithReviewer struct {
 	PullRequestName   string
 	AuthorID          string
 	AssignedReviewers []string
-	AuthorTeamName    string
+	TeamName          string
+	Version           int64
 }
 
 func (r *Repository) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) ([]OpenPRWithReviewer, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	if len(reviewerIDs) == 0 {
 		return []OpenPRWithReviewer{}, nil
 	}
@@ -25,9 +29,9 @@ func (r *Repository) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []st
 			pr.pull_request_name,
 			pr.author_id,
 			pr.assigned_reviewers,
-			u.team_name as author_team_name
+			COALESCE(pr.team_name, ''),
+			pr.version
 		FROM pullrequests pr
-		INNER JOIN users u ON pr.author_id = u.user_id
 		WHERE pr.status = 'OPEN'
 		  AND pr.assigned_reviewers && $1
 	`
@@ -48,7 +52,8 @@ func (r *Repository) GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []st
 			&pr.PullRequestName,
 			&pr.AuthorID,
 			&reviewers,
-			&pr.AuthorTeamName,
+			&pr.TeamName,
+			&pr.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -7,8 +7,14 @@ import (
 )
 
 func (r *Repository) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]user.User, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	rows, err := r.store.GetConn().QueryContext(ctx,
-		"SELECT user_id, username, team_name, is_active FROM users WHERE team_name = $1 AND is_active = TRUE AND user_id != $2",
+		`SELECT u.user_id, u.username, tm.team_name, u.is_active
+		 FROM team_members tm
+		 INNER JOIN users u ON u.user_id = tm.user_id
+		 WHERE tm.team_name = $1 AND u.is_active = TRUE AND u.user_id != $2`,
 		teamName, excludeUserID)
 	if err != nil {
 		return nil, err
@@ -6,14 +6,21 @@ import (
 	"errors"
 
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/lib/pq"
 )
 
 func (r *Repository) GetUser(ctx context.Context, userID string) (user.User, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var user user.User
+	var teamNames pq.StringArray
 
 	err := r.store.GetConn().QueryRowContext(ctx,
-		"SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1",
-		userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive)
+		`SELECT u.user_id, u.username, u.is_active,
+			ARRAY(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name)
+		 FROM users u WHERE u.user_id = $1`,
+		userID).Scan(&user.UserID, &user.Username, &user.IsActive, &teamNames)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return user, sql.ErrNoRows
@@ -21,5 +28,10 @@ func (r *Repository) GetUser(ctx context.Context, userID string) (user.User, err
 		return user, err
 	}
 
+	user.TeamNames = []string(teamNames)
+	if len(user.TeamNames) > 0 {
+		user.TeamName = user.TeamNames[0]
+	}
+
 	return user, nil
 }
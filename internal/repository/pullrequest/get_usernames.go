@@ -0,0 +1,40 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// GetUsernames возвращает имена пользователей по ID; несуществующие пропускаются
+func (r *Repository) GetUsernames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	if len(userIDs) == 0 {
+		return make(map[string]string), nil
+	}
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT user_id, username FROM users WHERE user_id = ANY($1)`,
+		pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]string)
+	for rows.Next() {
+		var userID, username string
+		if err := rows.Scan(&userID, &username); err != nil {
+			return nil, err
+		}
+		result[userID] = username
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,41 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// GetUsersActivity возвращает признак активности каждого существующего пользователя; несуществующие пропускаются
+func (r *Repository) GetUsersActivity(ctx context.Context, userIDs []string) (map[string]bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	if len(userIDs) == 0 {
+		return make(map[string]bool), nil
+	}
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT user_id, is_active FROM users WHERE user_id = ANY($1)`,
+		pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]bool)
+	for rows.Next() {
+		var userID string
+		var isActive bool
+		if err := rows.Scan(&userID, &isActive); err != nil {
+			return nil, err
+		}
+		result[userID] = isActive
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
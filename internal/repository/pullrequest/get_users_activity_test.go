@@ -0,0 +1,83 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_GetUsersActivity(t *testing.T) {
+	tests := []struct {
+		name           string
+		userIDs        []string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult map[string]bool
+		expectedError  error
+	}{
+		{
+			name:    "existing users only",
+			userIDs: []string{"u1", "u2", "ghost"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"user_id", "is_active"}).
+					AddRow("u1", true).
+					AddRow("u2", false)
+				mock.ExpectQuery(`SELECT user_id, is_active FROM users WHERE user_id = ANY\(\$1\)`).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+			expectedResult: map[string]bool{"u1": true, "u2": false},
+			expectedError:  nil,
+		},
+		{
+			name:           "empty list",
+			userIDs:        nil,
+			setupMock:      func(mock sqlmock.Sqlmock) {},
+			expectedResult: map[string]bool{},
+			expectedError:  nil,
+		},
+		{
+			name:    "database error",
+			userIDs: []string{"u1"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT user_id, is_active FROM users`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			result, err := NewRepository(store).GetUsersActivity(context.Background(), tt.userIDs)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -6,15 +6,20 @@ import (
 	"github.com/lib/pq"
 )
 
+// GetUsersTeamNames возвращает основную команду каждого пользователя; пользователи без команд пропускаются
 func (r *Repository) GetUsersTeamNames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	if len(userIDs) == 0 {
 		return make(map[string]string), nil
 	}
 
 	query := `
-		SELECT user_id, team_name
-		FROM users
+		SELECT user_id, MIN(team_name)
+		FROM team_members
 		WHERE user_id = ANY($1)
+		GROUP BY user_id
 	`
 
 	rows, err := r.store.GetConn().QueryContext(ctx, query, pq.Array(userIDs))
@@ -0,0 +1,88 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+)
+
+// ListPullRequests возвращает страницу PR по фильтру с keyset-пагинацией:
+// при сортировке по дате ключ - (created_at, pull_request_id), по ID - pull_request_id
+func (r *Repository) ListPullRequests(ctx context.Context, filter PullRequestListFilter) ([]PullRequest, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"TRUE"}
+	if filter.AuthorID != "" {
+		conditions = append(conditions, "author_id = "+arg(filter.AuthorID))
+	}
+	if filter.ReviewerID != "" {
+		conditions = append(conditions, "assigned_reviewers @> ARRAY["+arg(filter.ReviewerID)+"]::text[]")
+	}
+	if filter.TeamName != "" {
+		conditions = append(conditions, "team_name = "+arg(filter.TeamName))
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = "+arg(filter.Status))
+	}
+	if filter.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= "+arg(store.ToTimestamp(*filter.CreatedFrom)))
+	}
+	if filter.CreatedTo != nil {
+		conditions = append(conditions, "created_at < "+arg(store.ToTimestamp(*filter.CreatedTo)))
+	}
+
+	direction, compare := "ASC", ">"
+	if filter.Desc {
+		direction, compare = "DESC", "<"
+	}
+
+	var orderBy string
+	switch filter.SortBy {
+	case SortByID:
+		orderBy = "pull_request_id " + direction
+		if filter.AfterID != "" {
+			conditions = append(conditions, "pull_request_id "+compare+" "+arg(filter.AfterID))
+		}
+	default:
+		orderBy = "created_at " + direction + ", pull_request_id " + direction
+		if filter.AfterID != "" {
+			conditions = append(conditions, fmt.Sprintf("(created_at, pull_request_id) %s (%s, %s)",
+				compare, arg(store.ToTimestamp(filter.AfterCreatedAt)), arg(filter.AfterID)))
+		}
+	}
+
+	query := `SELECT ` + pullRequestColumns + ` FROM pullrequests
+		 WHERE ` + strings.Join(conditions, " AND ") + `
+		 ORDER BY ` + orderBy + `
+		 LIMIT ` + arg(filter.Limit)
+
+	rows, err := r.store.GetConn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []PullRequest
+	for rows.Next() {
+		pr, err := scanPullRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		prs = append(prs, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
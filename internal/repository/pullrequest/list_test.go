@@ -0,0 +1,179 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ListPullRequests(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		filter        PullRequestListFilter
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name:   "no filters",
+			filter: PullRequestListFilter{Limit: 11},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(pullRequestRowColumns).
+					AddRow("pr-001", "PR 1", "u1", "backend", "OPEN", pq.Array([]string{"u2"}), "{}", "{}", createdAt, nil, 1).
+					AddRow("pr-002", "PR 2", "u1", "backend", "MERGED", pq.Array([]string{}), "{}", "{}", createdAt, createdAt, 2)
+				mock.ExpectQuery(`FROM pullrequests\s+WHERE TRUE\s+ORDER BY created_at ASC, pull_request_id ASC\s+LIMIT \$1`).
+					WithArgs(11).
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "filters and cursor by created_at",
+			filter: PullRequestListFilter{
+				AuthorID:       "u1",
+				ReviewerID:     "u2",
+				TeamName:       "backend",
+				Status:         "OPEN",
+				CreatedFrom:    &createdAt,
+				SortBy:         SortByCreatedAt,
+				Desc:           true,
+				AfterCreatedAt: createdAt,
+				AfterID:        "pr-009",
+				Limit:          3,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(pullRequestRowColumns).
+					AddRow("pr-001", "PR 1", "u1", "backend", "OPEN", pq.Array([]string{"u2"}), "{}", "{}", createdAt, nil, 1)
+				mock.ExpectQuery(`WHERE TRUE AND author_id = \$1 AND assigned_reviewers @> ARRAY\[\$2\]::text\[\] AND team_name = \$3 AND status = \$4 AND created_at >= \$5 AND \(created_at, pull_request_id\) < \(\$6, \$7\)\s+ORDER BY created_at DESC, pull_request_id DESC\s+LIMIT \$8`).
+					WithArgs("u1", "u2", "backend", "OPEN", store.ToTimestamp(createdAt), store.ToTimestamp(createdAt), "pr-009", 3).
+					WillReturnRows(rows)
+			},
+			expectedCount: 1,
+		},
+		{
+			name:   "cursor by id",
+			filter: PullRequestListFilter{SortBy: SortByID, AfterID: "pr-001", CreatedTo: &createdAt, Limit: 5},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE TRUE AND created_at < \$1 AND pull_request_id > \$2\s+ORDER BY pull_request_id ASC\s+LIMIT \$3`).
+					WithArgs(store.ToTimestamp(createdAt), "pr-001", 5).
+					WillReturnRows(sqlmock.NewRows(pullRequestRowColumns))
+			},
+			expectedCount: 0,
+		},
+		{
+			name:   "database error",
+			filter: PullRequestListFilter{Limit: 1},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM pullrequests`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedError: errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			prs, err := NewRepository(store).ListPullRequests(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, prs)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, prs, tt.expectedCount)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+// wallClock сравнивает параметр TIMESTAMP по часам без пояса: пояс драйвер отбрасывает
+type wallClock string
+
+func (w wallClock) Match(v driver.Value) bool {
+	t, ok := v.(time.Time)
+	return ok && t.Format(time.DateTime) == string(w)
+}
+
+func TestRepository_ListPullRequests_CreatedAtRoundTrip(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC+3", 3*60*60)
+	defer func() { time.Local = local }()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	// created_at записан в 12:00 по локальному времени сервиса, драйвер помечает его как UTC
+	stored := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM pullrequests\s+WHERE TRUE\s+ORDER BY`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(pullRequestRowColumns).
+			AddRow("pr-001", "PR 1", "u1", "backend", "OPEN", "{}", "{}", "{}", stored, nil, 1))
+	mock.ExpectQuery(`WHERE TRUE AND created_at >= \$1\s+ORDER BY`).
+		WithArgs(wallClock("2025-01-01 12:00:00"), 1).
+		WillReturnRows(sqlmock.NewRows(pullRequestRowColumns))
+
+	store := store.New()
+	store.SetConn(db)
+	repo := NewRepository(store)
+
+	prs, err := repo.ListPullRequests(context.Background(), PullRequestListFilter{Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC), prs[0].CreatedAt.UTC())
+
+	// клиент получает created_at в JSON и передает его обратно, например в UTC
+	createdFrom := prs[0].CreatedAt.UTC()
+	_, err = repo.ListPullRequests(context.Background(), PullRequestListFilter{CreatedFrom: &createdFrom, Limit: 1})
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetUsernames(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"user_id", "username"}).AddRow("u1", "Alice").AddRow("u2", "Bob")
+	mock.ExpectQuery(`SELECT user_id, username FROM users WHERE user_id = ANY\(\$1\)`).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	store := store.New()
+	store.SetConn(db)
+
+	usernames, err := NewRepository(store).GetUsernames(context.Background(), []string{"u1", "u2", "ghost"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"u1": "Alice", "u2": "Bob"}, usernames)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
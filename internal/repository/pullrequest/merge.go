@@ -3,44 +3,31 @@ package pullrequest
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 )
 
-func (r *Repository) MergePullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
-	now := time.Now()
+// MergePullRequest помечает PR как MERGED, если его версия все еще равна version, и увеличивает версию.
+// Если PR изменили параллельно (или он удален), возвращает store.ErrConflict
+func (r *Repository) MergePullRequest(ctx context.Context, pullRequestID string, version int64) (PullRequest, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
 
-	_, err := r.store.GetConn().ExecContext(ctx,
-		`UPDATE pullrequests SET status = 'MERGED', merged_at = $1 WHERE pull_request_id = $2`,
-		now, pullRequestID)
-	if err != nil {
-		return PullRequest{}, err
-	}
-
-	var pr PullRequest
-	var assignedReviewers pq.StringArray
+	now := time.Now()
 
-	err = r.store.GetConn().QueryRowContext(ctx,
-		`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at 
-		 FROM pullrequests WHERE pull_request_id = $1`,
-		pullRequestID).Scan(
-		&pr.PullRequestID,
-		&pr.PullRequestName,
-		&pr.AuthorID,
-		&pr.Status,
-		&assignedReviewers,
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
+	pr, err := scanPullRequest(r.store.GetConn().QueryRowContext(ctx,
+		`UPDATE pullrequests SET status = 'MERGED', merged_at = $1, version = version + 1
+		 WHERE pull_request_id = $2 AND version = $3
+		 RETURNING `+pullRequestColumns,
+		now, pullRequestID, version))
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return PullRequest{}, sql.ErrNoRows
+		if errors.Is(err, sql.ErrNoRows) {
+			return PullRequest{}, store.ErrConflict
 		}
 		return PullRequest{}, err
 	}
 
-	pr.AssignedReviewers = []string(assignedReviewers)
-
 	return pr, nil
 }
@@ -2,14 +2,13 @@ package pullrequest
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
-	"github.com/lib/pq"
 	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +16,7 @@ func TestRepository_MergePullRequest(t *testing.T) {
 	tests := []struct {
 		name           string
 		pullRequestID  string
+		version        int64
 		setupMock      func(mock sqlmock.Sqlmock)
 		expectedResult PullRequest
 		expectedError  error
@@ -24,119 +24,71 @@ func TestRepository_MergePullRequest(t *testing.T) {
 		{
 			name:          "successful merge",
 			pullRequestID: "pr-001",
+			version:       2,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
-					WithArgs(sqlmock.AnyArg(), "pr-001").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				createdAt := time.Now()
-				mergedAt := time.Now()
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-					AddRow("pr-001", "Test PR", "user-001", "MERGED", pq.Array([]string{"user-002", "user-003"}), createdAt, mergedAt)
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-001").
+				rows := sqlmock.NewRows(pullRequestRowColumns).
+					AddRow("pr-001", "Test PR", "user-001", "backend", "MERGED", pq.Array([]string{"user-002", "user-003"}), "{}", "{}", time.Now(), time.Now(), 3)
+				mock.ExpectQuery(`UPDATE pullrequests SET status = 'MERGED', merged_at = \$1, version = version \+ 1\s+WHERE pull_request_id = \$2 AND version = \$3\s+RETURNING pull_request_id`).
+					WithArgs(sqlmock.AnyArg(), "pr-001", int64(2)).
 					WillReturnRows(rows)
 			},
 			expectedResult: PullRequest{
 				PullRequestID:     "pr-001",
 				PullRequestName:   "Test PR",
 				AuthorID:          "user-001",
+				TeamName:          "backend",
 				Status:            "MERGED",
 				AssignedReviewers: []string{"user-002", "user-003"},
-			},
-			expectedError: nil,
-		},
-		{
-			name:          "successful merge with single reviewer",
-			pullRequestID: "pr-002",
-			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
-					WithArgs(sqlmock.AnyArg(), "pr-002").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				createdAt := time.Now()
-				mergedAt := time.Now()
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-					AddRow("pr-002", "Test PR 2", "user-001", "MERGED", pq.Array([]string{"user-002"}), createdAt, mergedAt)
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-002").
-					WillReturnRows(rows)
-			},
-			expectedResult: PullRequest{
-				PullRequestID:     "pr-002",
-				PullRequestName:   "Test PR 2",
-				AuthorID:          "user-001",
-				Status:            "MERGED",
-				AssignedReviewers: []string{"user-002"},
+				Version:           3,
 			},
 			expectedError: nil,
 		},
 		{
 			name:          "successful merge with empty reviewers",
 			pullRequestID: "pr-003",
+			version:       1,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
-					WithArgs(sqlmock.AnyArg(), "pr-003").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				createdAt := time.Now()
-				mergedAt := time.Now()
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-					AddRow("pr-003", "Test PR 3", "user-001", "MERGED", pq.Array([]string{}), createdAt, mergedAt)
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-003").
+				rows := sqlmock.NewRows(pullRequestRowColumns).
+					AddRow("pr-003", "Test PR 3", "user-001", "backend", "MERGED", pq.Array([]string{}), "{}", "{}", time.Now(), time.Now(), 2)
+				mock.ExpectQuery(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
+					WithArgs(sqlmock.AnyArg(), "pr-003", int64(1)).
 					WillReturnRows(rows)
 			},
 			expectedResult: PullRequest{
 				PullRequestID:     "pr-003",
 				PullRequestName:   "Test PR 3",
 				AuthorID:          "user-001",
+				TeamName:          "backend",
 				Status:            "MERGED",
 				AssignedReviewers: []string{},
+				Version:           2,
 			},
 			expectedError: nil,
 		},
 		{
-			name:          "PR not found after update",
+			name:          "version changed concurrently",
 			pullRequestID: "pr-999",
+			version:       1,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
-					WithArgs(sqlmock.AnyArg(), "pr-999").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-999").
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
+					WithArgs(sqlmock.AnyArg(), "pr-999", int64(1)).
+					WillReturnRows(sqlmock.NewRows(pullRequestRowColumns))
 			},
 			expectedResult: PullRequest{},
-			expectedError:  sql.ErrNoRows,
+			expectedError:  store.ErrConflict,
 		},
 		{
 			name:          "database error on update",
 			pullRequestID: "pr-004",
+			version:       1,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
-					WithArgs(sqlmock.AnyArg(), "pr-004").
+				mock.ExpectQuery(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
+					WithArgs(sqlmock.AnyArg(), "pr-004", int64(1)).
 					WillReturnError(errors.New("database connection error"))
 			},
 			expectedResult: PullRequest{},
 			expectedError:  errors.New("database connection error"),
 		},
-		{
-			name:          "database error on select",
-			pullRequestID: "pr-005",
-			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET status = 'MERGED', merged_at`).
-					WithArgs(sqlmock.AnyArg(), "pr-005").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-005").
-					WillReturnError(errors.New("database query error"))
-			},
-			expectedResult: PullRequest{},
-			expectedError:  errors.New("database query error"),
-		},
 	}
 
 	for _, tt := range tests {
@@ -155,15 +107,11 @@ func TestRepository_MergePullRequest(t *testing.T) {
 			repo := NewRepository(store)
 
 			ctx := context.Background()
-			result, err := repo.MergePullRequest(ctx, tt.pullRequestID)
+			result, err := repo.MergePullRequest(ctx, tt.pullRequestID, tt.version)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
-				if errors.Is(tt.expectedError, sql.ErrNoRows) {
-					assert.ErrorIs(t, err, sql.ErrNoRows)
-				} else {
-					assert.Contains(t, err.Error(), tt.expectedError.Error())
-				}
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
 				assert.Equal(t, PullRequest{}, result)
 			} else {
 				assert.NoError(t, err)
@@ -172,6 +120,7 @@ func TestRepository_MergePullRequest(t *testing.T) {
 				assert.Equal(t, tt.expectedResult.AuthorID, result.AuthorID)
 				assert.Equal(t, "MERGED", result.Status)
 				assert.Equal(t, tt.expectedResult.AssignedReviewers, result.AssignedReviewers)
+				assert.Equal(t, tt.expectedResult.Version, result.Version)
 				assert.NotNil(t, result.MergedAt)
 			}
 
@@ -181,4 +130,3 @@ func TestRepository_MergePullRequest(t *testing.T) {
 		})
 	}
 }
-
@@ -0,0 +1,70 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// GetActiveOwners раскрывает владельцев (пользователей и команды) в список активных пользователей без автора
+func (r *Repository) GetActiveOwners(ctx context.Context, userIDs, teamNames []string, excludeUserID string) ([]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT u.user_id
+		 FROM users u
+		 WHERE u.is_active = TRUE AND u.user_id != $3
+		   AND (u.user_id = ANY($1)
+		        OR EXISTS (SELECT 1 FROM team_members tm WHERE tm.user_id = u.user_id AND tm.team_name = ANY($2)))
+		 ORDER BY u.user_id`,
+		pq.Array(userIDs), pq.Array(teamNames), excludeUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanUserIDs(rows)
+}
+
+// GetUsersWithExpertise отбирает из userIDs пользователей, у которых есть хотя бы один из тегов
+func (r *Repository) GetUsersWithExpertise(ctx context.Context, userIDs, tags []string) ([]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT DISTINCT ue.user_id
+		 FROM user_expertise ue
+		 WHERE ue.user_id = ANY($1) AND ue.tag = ANY($2)
+		 ORDER BY ue.user_id`,
+		pq.Array(userIDs), pq.Array(tags))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanUserIDs(rows)
+}
+
+type rowsScanner interface {
+	rowScanner
+	Next() bool
+	Err() error
+}
+
+func scanUserIDs(rows rowsScanner) ([]string, error) {
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
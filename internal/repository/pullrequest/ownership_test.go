@@ -0,0 +1,93 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_GetActiveOwners(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []string
+		expectedError  error
+	}{
+		{
+			name: "users and team members",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"user_id"}).AddRow("u2").AddRow("u3")
+				mock.ExpectQuery(`SELECT u.user_id\s+FROM users u`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "u1").
+					WillReturnRows(rows)
+			},
+			expectedResult: []string{"u2", "u3"},
+			expectedError:  nil,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT u.user_id`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			owners, err := NewRepository(store).GetActiveOwners(context.Background(), []string{"u2"}, []string{"backend"}, "u1")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, owners)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, owners)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRepository_GetUsersWithExpertise(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"user_id"}).AddRow("u3")
+	mock.ExpectQuery(`SELECT DISTINCT ue.user_id\s+FROM user_expertise ue`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	store := store.New()
+	store.SetConn(db)
+
+	experts, err := NewRepository(store).GetUsersWithExpertise(context.Background(), []string{"u2", "u3"}, []string{"go"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u3"}, experts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
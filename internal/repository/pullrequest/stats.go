@@ -12,16 +12,19 @@ type ReviewerStats struct {
 }
 
 func (r *Repository) GetReviewerStats(ctx context.Context) ([]ReviewerStats, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT 
 			u.user_id,
 			u.username,
-			u.team_name,
+			COALESCE((SELECT MIN(tm.team_name) FROM team_members tm WHERE tm.user_id = u.user_id), ''),
 			COUNT(*) as assignments_count
 		FROM users u
 		INNER JOIN pullrequests pr ON u.user_id = ANY(pr.assigned_reviewers)
 		WHERE u.is_active = TRUE
-		GROUP BY u.user_id, u.username, u.team_name
+		GROUP BY u.user_id, u.username
 		ORDER BY assignments_count DESC
 	`
 
@@ -54,6 +57,9 @@ type PRStats struct {
 }
 
 func (r *Repository) GetPRStats(ctx context.Context) (PRStats, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var stats PRStats
 
 	err := r.store.GetConn().QueryRowContext(ctx,
@@ -3,41 +3,29 @@ package pullrequest
 import (
 	"context"
 	"database/sql"
+	"errors"
 
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 	"github.com/lib/pq"
 )
 
-func (r *Repository) UpdatePullRequestReviewers(ctx context.Context, pullRequestID string, assignedReviewers []string) (PullRequest, error) {
-	_, err := r.store.GetConn().ExecContext(ctx,
-		`UPDATE pullrequests SET assigned_reviewers = $1 WHERE pull_request_id = $2`,
-		pq.Array(assignedReviewers), pullRequestID)
-	if err != nil {
-		return PullRequest{}, err
-	}
+// UpdatePullRequestReviewers заменяет ревьюверов PR, если его версия все еще равна version,
+// и увеличивает версию. Если PR изменили параллельно (или он удален), возвращает store.ErrConflict
+func (r *Repository) UpdatePullRequestReviewers(ctx context.Context, pullRequestID string, version int64, assignedReviewers []string) (PullRequest, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
 
-	var pr PullRequest
-	var reviewers pq.StringArray
-
-	err = r.store.GetConn().QueryRowContext(ctx,
-		`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at 
-		 FROM pullrequests WHERE pull_request_id = $1`,
-		pullRequestID).Scan(
-		&pr.PullRequestID,
-		&pr.PullRequestName,
-		&pr.AuthorID,
-		&pr.Status,
-		&reviewers,
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
+	pr, err := scanPullRequest(r.store.GetConn().QueryRowContext(ctx,
+		`UPDATE pullrequests SET assigned_reviewers = $1, version = version + 1
+		 WHERE pull_request_id = $2 AND version = $3
+		 RETURNING `+pullRequestColumns,
+		pq.Array(assignedReviewers), pullRequestID, version))
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return PullRequest{}, sql.ErrNoRows
+		if errors.Is(err, sql.ErrNoRows) {
+			return PullRequest{}, store.ErrConflict
 		}
 		return PullRequest{}, err
 	}
 
-	pr.AssignedReviewers = []string(reviewers)
-
 	return pr, nil
 }
@@ -2,145 +2,100 @@ package pullrequest
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
-	"github.com/lib/pq"
 	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
+var pullRequestRowColumns = []string{"pull_request_id", "pull_request_name", "author_id", "team_name", "status", "assigned_reviewers", "files", "labels", "created_at", "merged_at", "version"}
+
 func TestRepository_UpdatePullRequestReviewers(t *testing.T) {
 	tests := []struct {
-		name                string
-		pullRequestID       string
-		assignedReviewers   []string
-		setupMock           func(mock sqlmock.Sqlmock)
-		expectedResult      PullRequest
-		expectedError       error
+		name              string
+		pullRequestID     string
+		version           int64
+		assignedReviewers []string
+		setupMock         func(mock sqlmock.Sqlmock)
+		expectedResult    PullRequest
+		expectedError     error
 	}{
 		{
 			name:              "successful update with 2 reviewers",
 			pullRequestID:     "pr-001",
+			version:           1,
 			assignedReviewers: []string{"user-002", "user-003"},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers`).
-					WithArgs(sqlmock.AnyArg(), "pr-001").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				createdAt := time.Now()
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-					AddRow("pr-001", "Test PR", "user-001", "OPEN", pq.Array([]string{"user-002", "user-003"}), createdAt, nil)
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-001").
+				rows := sqlmock.NewRows(pullRequestRowColumns).
+					AddRow("pr-001", "Test PR", "user-001", "backend", "OPEN", pq.Array([]string{"user-002", "user-003"}), "{}", "{}", time.Now(), nil, 2)
+				mock.ExpectQuery(`UPDATE pullrequests SET assigned_reviewers = \$1, version = version \+ 1\s+WHERE pull_request_id = \$2 AND version = \$3\s+RETURNING pull_request_id`).
+					WithArgs(sqlmock.AnyArg(), "pr-001", int64(1)).
 					WillReturnRows(rows)
 			},
 			expectedResult: PullRequest{
 				PullRequestID:     "pr-001",
 				PullRequestName:   "Test PR",
 				AuthorID:          "user-001",
+				TeamName:          "backend",
 				Status:            "OPEN",
 				AssignedReviewers: []string{"user-002", "user-003"},
-			},
-			expectedError: nil,
-		},
-		{
-			name:              "successful update with 1 reviewer",
-			pullRequestID:     "pr-002",
-			assignedReviewers: []string{"user-002"},
-			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers`).
-					WithArgs(sqlmock.AnyArg(), "pr-002").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				createdAt := time.Now()
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-					AddRow("pr-002", "Test PR 2", "user-001", "OPEN", pq.Array([]string{"user-002"}), createdAt, nil)
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-002").
-					WillReturnRows(rows)
-			},
-			expectedResult: PullRequest{
-				PullRequestID:     "pr-002",
-				PullRequestName:   "Test PR 2",
-				AuthorID:          "user-001",
-				Status:            "OPEN",
-				AssignedReviewers: []string{"user-002"},
+				Version:           2,
 			},
 			expectedError: nil,
 		},
 		{
 			name:              "successful update with empty reviewers",
 			pullRequestID:     "pr-003",
+			version:           4,
 			assignedReviewers: []string{},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers`).
-					WithArgs(sqlmock.AnyArg(), "pr-003").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				createdAt := time.Now()
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-					AddRow("pr-003", "Test PR 3", "user-001", "OPEN", pq.Array([]string{}), createdAt, nil)
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-003").
+				rows := sqlmock.NewRows(pullRequestRowColumns).
+					AddRow("pr-003", "Test PR 3", "user-001", "backend", "OPEN", pq.Array([]string{}), "{}", "{}", time.Now(), nil, 5)
+				mock.ExpectQuery(`UPDATE pullrequests SET assigned_reviewers`).
+					WithArgs(sqlmock.AnyArg(), "pr-003", int64(4)).
 					WillReturnRows(rows)
 			},
 			expectedResult: PullRequest{
 				PullRequestID:     "pr-003",
 				PullRequestName:   "Test PR 3",
 				AuthorID:          "user-001",
+				TeamName:          "backend",
 				Status:            "OPEN",
 				AssignedReviewers: []string{},
+				Version:           5,
 			},
 			expectedError: nil,
 		},
 		{
-			name:              "PR not found after update",
+			name:              "version changed concurrently",
 			pullRequestID:     "pr-004",
+			version:           1,
 			assignedReviewers: []string{"user-002"},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers`).
-					WithArgs(sqlmock.AnyArg(), "pr-004").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-004").
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(`UPDATE pullrequests SET assigned_reviewers`).
+					WithArgs(sqlmock.AnyArg(), "pr-004", int64(1)).
+					WillReturnRows(sqlmock.NewRows(pullRequestRowColumns))
 			},
 			expectedResult: PullRequest{},
-			expectedError:  sql.ErrNoRows,
+			expectedError:  store.ErrConflict,
 		},
 		{
 			name:              "database error on update",
 			pullRequestID:     "pr-005",
+			version:           1,
 			assignedReviewers: []string{"user-002"},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers`).
-					WithArgs(sqlmock.AnyArg(), "pr-005").
+				mock.ExpectQuery(`UPDATE pullrequests SET assigned_reviewers`).
+					WithArgs(sqlmock.AnyArg(), "pr-005", int64(1)).
 					WillReturnError(errors.New("database connection error"))
 			},
 			expectedResult: PullRequest{},
 			expectedError:  errors.New("database connection error"),
 		},
-		{
-			name:              "database error on select",
-			pullRequestID:     "pr-006",
-			assignedReviewers: []string{"user-002"},
-			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers`).
-					WithArgs(sqlmock.AnyArg(), "pr-006").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`).
-					WithArgs("pr-006").
-					WillReturnError(errors.New("database query error"))
-			},
-			expectedResult: PullRequest{},
-			expectedError:  errors.New("database query error"),
-		},
 	}
 
 	for _, tt := range tests {
@@ -159,15 +114,11 @@ func TestRepository_UpdatePullRequestReviewers(t *testing.T) {
 			repo := NewRepository(store)
 
 			ctx := context.Background()
-			result, err := repo.UpdatePullRequestReviewers(ctx, tt.pullRequestID, tt.assignedReviewers)
+			result, err := repo.UpdatePullRequestReviewers(ctx, tt.pullRequestID, tt.version, tt.assignedReviewers)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
-				if errors.Is(tt.expectedError, sql.ErrNoRows) {
-					assert.ErrorIs(t, err, sql.ErrNoRows)
-				} else {
-					assert.Contains(t, err.Error(), tt.expectedError.Error())
-				}
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
 				assert.Equal(t, PullRequest{}, result)
 			} else {
 				assert.NoError(t, err)
@@ -176,6 +127,7 @@ func TestRepository_UpdatePullRequestReviewers(t *testing.T) {
 				assert.Equal(t, tt.expectedResult.AuthorID, result.AuthorID)
 				assert.Equal(t, tt.expectedResult.Status, result.Status)
 				assert.Equal(t, tt.expectedResult.AssignedReviewers, result.AssignedReviewers)
+				assert.Equal(t, tt.expectedResult.Version, result.Version)
 			}
 
 			if err := mock.ExpectationsWereMet(); err != nil {
@@ -184,4 +136,3 @@ func TestRepository_UpdatePullRequestReviewers(t *testing.T) {
 		})
 	}
 }
-
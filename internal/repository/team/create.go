@@ -1,17 +1,27 @@
 package team
 
-import "context"
+import (
+	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+)
 
 func (r *Repository) CreateTeam(ctx context.Context, teamName string) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	_, err := r.store.GetConn().ExecContext(ctx,
 		"INSERT INTO teams (team_name) VALUES ($1)",
 		teamName)
-	return err
+	return store.TranslateDBErr(err)
 }
 
-func (r *Repository) CreateUser(ctx context.Context, userID, username, teamName string, isActive bool) error {
+func (r *Repository) CreateUser(ctx context.Context, userID, username string, isActive bool) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	_, err := r.store.GetConn().ExecContext(ctx,
-		"INSERT INTO users (user_id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-		userID, username, teamName, isActive)
-	return err
+		"INSERT INTO users (user_id, username, is_active) VALUES ($1, $2, $3)",
+		userID, username, isActive)
+	return store.TranslateDBErr(err)
 }
@@ -86,7 +86,6 @@ func TestRepository_CreateUser(t *testing.T) {
 		name          string
 		userID        string
 		username      string
-		teamName      string
 		isActive      bool
 		setupMock     func(mock sqlmock.Sqlmock)
 		expectedError error
@@ -95,11 +94,10 @@ func TestRepository_CreateUser(t *testing.T) {
 			name:     "successful creation",
 			userID:   "user-001",
 			username: "alice",
-			teamName: "backend",
 			isActive: true,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO users \(user_id, username, team_name, is_active\) VALUES`).
-					WithArgs("user-001", "alice", "backend", true).
+				mock.ExpectExec(`INSERT INTO users \(user_id, username, is_active\) VALUES`).
+					WithArgs("user-001", "alice", true).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: nil,
@@ -108,11 +106,10 @@ func TestRepository_CreateUser(t *testing.T) {
 			name:     "successful creation with inactive user",
 			userID:   "user-002",
 			username: "bob",
-			teamName: "frontend",
 			isActive: false,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO users \(user_id, username, team_name, is_active\) VALUES`).
-					WithArgs("user-002", "bob", "frontend", false).
+				mock.ExpectExec(`INSERT INTO users \(user_id, username, is_active\) VALUES`).
+					WithArgs("user-002", "bob", false).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: nil,
@@ -121,11 +118,10 @@ func TestRepository_CreateUser(t *testing.T) {
 			name:     "duplicate user id",
 			userID:   "user-001",
 			username: "alice",
-			teamName: "backend",
 			isActive: true,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO users \(user_id, username, team_name, is_active\) VALUES`).
-					WithArgs("user-001", "alice", "backend", true).
+				mock.ExpectExec(`INSERT INTO users \(user_id, username, is_active\) VALUES`).
+					WithArgs("user-001", "alice", true).
 					WillReturnError(errors.New("duplicate key value violates unique constraint"))
 			},
 			expectedError: errors.New("duplicate key value violates unique constraint"),
@@ -134,11 +130,10 @@ func TestRepository_CreateUser(t *testing.T) {
 			name:     "database error",
 			userID:   "user-003",
 			username: "charlie",
-			teamName: "devops",
 			isActive: true,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO users \(user_id, username, team_name, is_active\) VALUES`).
-					WithArgs("user-003", "charlie", "devops", true).
+				mock.ExpectExec(`INSERT INTO users \(user_id, username, is_active\) VALUES`).
+					WithArgs("user-003", "charlie", true).
 					WillReturnError(errors.New("database connection error"))
 			},
 			expectedError: errors.New("database connection error"),
@@ -161,7 +156,7 @@ func TestRepository_CreateUser(t *testing.T) {
 			repo := NewRepository(store)
 
 			ctx := context.Background()
-			err = repo.CreateUser(ctx, tt.userID, tt.username, tt.teamName, tt.isActive)
+			err = repo.CreateUser(ctx, tt.userID, tt.username, tt.isActive)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -1,7 +1,32 @@
 package team
 
+// User участник команды; для пользователя без команд TeamName и Role пустые
 type User struct {
 	UserID   string
 	Username string
+	TeamName string
+	Role     string
 	IsActive bool
 }
+
+type TeamSummary struct {
+	TeamName           string
+	MembersCount       int
+	ActiveMembersCount int
+	OpenPRsCount       int
+}
+
+// TeamListFilter фильтр и страница списка команд; Prefix - начало имени команды
+type TeamListFilter struct {
+	Prefix string
+	Limit  int
+	Offset int
+}
+
+// OpenReview открытый PR, в котором назначен кто-то из переданных ревьюверов
+type OpenReview struct {
+	PullRequestID     string
+	AuthorID          string
+	TeamName          string
+	AssignedReviewers []string
+}
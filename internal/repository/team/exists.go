@@ -3,6 +3,9 @@ package team
 import "context"
 
 func (r *Repository) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var exists bool
 	err := r.store.GetConn().QueryRowContext(ctx,
 		"SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)",
@@ -14,6 +17,9 @@ func (r *Repository) TeamExists(ctx context.Context, teamName string) (bool, err
 }
 
 func (r *Repository) UserExists(ctx context.Context, userID string) (bool, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var exists bool
 	err := r.store.GetConn().QueryRowContext(ctx,
 		"SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)",
@@ -6,6 +6,9 @@ import (
 )
 
 func (r *Repository) GetTeam(ctx context.Context, teamName string) (string, []User, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var exists bool
 	err := r.store.GetConn().QueryRowContext(ctx,
 		"SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)",
@@ -18,7 +21,11 @@ func (r *Repository) GetTeam(ctx context.Context, teamName string) (string, []Us
 	}
 
 	rows, err := r.store.GetConn().QueryContext(ctx,
-		"SELECT user_id, username, is_active FROM users WHERE team_name = $1",
+		`SELECT u.user_id, u.username, tm.role, u.is_active
+		 FROM team_members tm
+		 INNER JOIN users u ON u.user_id = tm.user_id
+		 WHERE tm.team_name = $1
+		 ORDER BY u.user_id`,
 		teamName)
 	if err != nil {
 		return "", nil, err
@@ -27,8 +34,8 @@ func (r *Repository) GetTeam(ctx context.Context, teamName string) (string, []Us
 
 	var users []User
 	for rows.Next() {
-		var u User
-		if err := rows.Scan(&u.UserID, &u.Username, &u.IsActive); err != nil {
+		u := User{TeamName: teamName}
+		if err := rows.Scan(&u.UserID, &u.Username, &u.Role, &u.IsActive); err != nil {
 			return "", nil, err
 		}
 		users = append(users, u)
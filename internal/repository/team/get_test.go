@@ -31,11 +31,11 @@ func TestRepository_GetTeam(t *testing.T) {
 					WithArgs("backend").
 					WillReturnRows(existsRow)
 
-				usersRows := sqlmock.NewRows([]string{"user_id", "username", "is_active"}).
-					AddRow("user-001", "alice", true).
-					AddRow("user-002", "bob", true).
-					AddRow("user-003", "charlie", false)
-				mock.ExpectQuery(`SELECT user_id, username, is_active FROM users WHERE team_name`).
+				usersRows := sqlmock.NewRows([]string{"user_id", "username", "role", "is_active"}).
+					AddRow("user-001", "alice", "lead", true).
+					AddRow("user-002", "bob", "member", true).
+					AddRow("user-003", "charlie", "member", false)
+				mock.ExpectQuery(`SELECT u.user_id, u.username, tm.role, u.is_active\s+FROM team_members tm\s+INNER JOIN users u ON u.user_id = tm.user_id\s+WHERE tm.team_name`).
 					WithArgs("backend").
 					WillReturnRows(usersRows)
 			},
@@ -45,9 +45,9 @@ func TestRepository_GetTeam(t *testing.T) {
 			}{
 				teamName: "backend",
 				users: []User{
-					{UserID: "user-001", Username: "alice", IsActive: true},
-					{UserID: "user-002", Username: "bob", IsActive: true},
-					{UserID: "user-003", Username: "charlie", IsActive: false},
+					{UserID: "user-001", Username: "alice", TeamName: "backend", Role: "lead", IsActive: true},
+					{UserID: "user-002", Username: "bob", TeamName: "backend", Role: "member", IsActive: true},
+					{UserID: "user-003", Username: "charlie", TeamName: "backend", Role: "member", IsActive: false},
 				},
 			},
 			expectedError: nil,
@@ -61,9 +61,9 @@ func TestRepository_GetTeam(t *testing.T) {
 					WithArgs("frontend").
 					WillReturnRows(existsRow)
 
-				usersRows := sqlmock.NewRows([]string{"user_id", "username", "is_active"}).
-					AddRow("user-004", "david", true)
-				mock.ExpectQuery(`SELECT user_id, username, is_active FROM users WHERE team_name`).
+				usersRows := sqlmock.NewRows([]string{"user_id", "username", "role", "is_active"}).
+					AddRow("user-004", "david", "member", true)
+				mock.ExpectQuery(`SELECT u.user_id, u.username, tm.role, u.is_active\s+FROM team_members tm\s+INNER JOIN users u ON u.user_id = tm.user_id\s+WHERE tm.team_name`).
 					WithArgs("frontend").
 					WillReturnRows(usersRows)
 			},
@@ -73,7 +73,7 @@ func TestRepository_GetTeam(t *testing.T) {
 			}{
 				teamName: "frontend",
 				users: []User{
-					{UserID: "user-004", Username: "david", IsActive: true},
+					{UserID: "user-004", Username: "david", TeamName: "frontend", Role: "member", IsActive: true},
 				},
 			},
 			expectedError: nil,
@@ -87,8 +87,8 @@ func TestRepository_GetTeam(t *testing.T) {
 					WithArgs("empty-team").
 					WillReturnRows(existsRow)
 
-				usersRows := sqlmock.NewRows([]string{"user_id", "username", "is_active"})
-				mock.ExpectQuery(`SELECT user_id, username, is_active FROM users WHERE team_name`).
+				usersRows := sqlmock.NewRows([]string{"user_id", "username", "role", "is_active"})
+				mock.ExpectQuery(`SELECT u.user_id, u.username, tm.role, u.is_active\s+FROM team_members tm\s+INNER JOIN users u ON u.user_id = tm.user_id\s+WHERE tm.team_name`).
 					WithArgs("empty-team").
 					WillReturnRows(usersRows)
 			},
@@ -145,7 +145,7 @@ func TestRepository_GetTeam(t *testing.T) {
 					WithArgs("backend").
 					WillReturnRows(existsRow)
 
-				mock.ExpectQuery(`SELECT user_id, username, is_active FROM users WHERE team_name`).
+				mock.ExpectQuery(`SELECT u.user_id, u.username, tm.role, u.is_active\s+FROM team_members tm\s+INNER JOIN users u ON u.user_id = tm.user_id\s+WHERE tm.team_name`).
 					WithArgs("backend").
 					WillReturnError(errors.New("database query error"))
 			},
@@ -0,0 +1,66 @@
+package team
+
+import (
+	"github.com/lib/pq"
+)
+
+// LockTeams блокирует существующие команды из teamNames до конца транзакции и возвращает их имена.
+// Блокировки берутся в порядке имен, чтобы параллельные импорты не попадали в дедлок
+func (t *Transaction) LockTeams(teamNames []string) ([]string, error) {
+	rows, err := t.tx.Query(
+		"SELECT team_name FROM teams WHERE team_name = ANY($1) ORDER BY team_name FOR UPDATE",
+		pq.Array(teamNames))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var teamName string
+		if err := rows.Scan(&teamName); err != nil {
+			return nil, err
+		}
+		teams = append(teams, teamName)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// GetMemberships возвращает все членства пользователей с указанными ID и всех участников указанных команд,
+// по строке на пару пользователь-команда; пользователь без команд возвращается одной строкой с пустой командой.
+// Строки пользователей блокируются до конца транзакции
+func (t *Transaction) GetMemberships(userIDs, teamNames []string) ([]User, error) {
+	rows, err := t.tx.Query(
+		`SELECT u.user_id, u.username, COALESCE(tm.team_name, ''), COALESCE(tm.role, ''), u.is_active
+		 FROM users u
+		 LEFT JOIN team_members tm ON tm.user_id = u.user_id
+		 WHERE u.user_id = ANY($1)
+		    OR u.user_id IN (SELECT user_id FROM team_members WHERE team_name = ANY($2))
+		 ORDER BY u.user_id, tm.team_name
+		 FOR UPDATE OF u`,
+		pq.Array(userIDs), pq.Array(teamNames))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.TeamName, &u.Role, &u.IsActive); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
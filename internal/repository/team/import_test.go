@@ -0,0 +1,134 @@
+package team
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction_LockTeams(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamNames      []string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []string
+		expectedError  error
+	}{
+		{
+			name:      "some teams exist",
+			teamNames: []string{"backend", "platform"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"team_name"}).AddRow("backend")
+				mock.ExpectQuery(`SELECT team_name FROM teams WHERE team_name = ANY\(\$1\) ORDER BY team_name FOR UPDATE`).
+					WithArgs(pq.Array([]string{"backend", "platform"})).
+					WillReturnRows(rows)
+			},
+			expectedResult: []string{"backend"},
+			expectedError:  nil,
+		},
+		{
+			name:      "database error",
+			teamNames: []string{"backend"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT team_name FROM teams`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, mock := newTestTx(t)
+			tt.setupMock(mock)
+
+			teams, err := tx.LockTeams(tt.teamNames)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, teams)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, teams)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_GetMemberships(t *testing.T) {
+	tests := []struct {
+		name           string
+		userIDs        []string
+		teamNames      []string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []User
+		expectedError  error
+	}{
+		{
+			name:      "memberships of roster users and team members",
+			userIDs:   []string{"u1", "u3", "u5"},
+			teamNames: []string{"backend"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "role", "is_active"}).
+					AddRow("u1", "Alice", "backend", "lead", true).
+					AddRow("u1", "Alice", "devops", "member", true).
+					AddRow("u3", "Carol", "frontend", "member", true).
+					AddRow("u4", "Dave", "backend", "member", false).
+					AddRow("u5", "Eve", "", "", true)
+				mock.ExpectQuery(`SELECT u.user_id, u.username, COALESCE\(tm.team_name, ''\), COALESCE\(tm.role, ''\), u.is_active\s+FROM users u\s+LEFT JOIN team_members tm ON tm.user_id = u.user_id\s+WHERE u.user_id = ANY\(\$1\)\s+OR u.user_id IN \(SELECT user_id FROM team_members WHERE team_name = ANY\(\$2\)\)\s+ORDER BY u.user_id, tm.team_name\s+FOR UPDATE OF u`).
+					WithArgs(pq.Array([]string{"u1", "u3", "u5"}), pq.Array([]string{"backend"})).
+					WillReturnRows(rows)
+			},
+			expectedResult: []User{
+				{UserID: "u1", Username: "Alice", TeamName: "backend", Role: "lead", IsActive: true},
+				{UserID: "u1", Username: "Alice", TeamName: "devops", Role: "member", IsActive: true},
+				{UserID: "u3", Username: "Carol", TeamName: "frontend", Role: "member", IsActive: true},
+				{UserID: "u4", Username: "Dave", TeamName: "backend", Role: "member", IsActive: false},
+				{UserID: "u5", Username: "Eve", IsActive: true},
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "database error",
+			userIDs:   []string{"u1"},
+			teamNames: []string{"backend"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT u.user_id, u.username, COALESCE\(tm.team_name`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, mock := newTestTx(t)
+			tt.setupMock(mock)
+
+			users, err := tx.GetMemberships(tt.userIDs, tt.teamNames)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, users)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, users)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package team
+
+import (
+	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+)
+
+// ListTeams возвращает страницу команд, упорядоченных по имени, с количеством участников
+// (всего и активных) и открытых PR
+func (r *Repository) ListTeams(ctx context.Context, filter TeamListFilter) ([]TeamSummary, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT
+			t.team_name,
+			COUNT(u.user_id) AS members_count,
+			COUNT(u.user_id) FILTER (WHERE u.is_active) AS active_members_count,
+			(SELECT COUNT(*) FROM pullrequests pr
+			 WHERE pr.team_name = t.team_name AND pr.status = 'OPEN') AS open_prs_count
+		FROM teams t
+		LEFT JOIN team_members tm ON tm.team_name = t.team_name
+		LEFT JOIN users u ON u.user_id = tm.user_id
+		WHERE t.team_name LIKE $1 || '%'
+		GROUP BY t.team_name
+		ORDER BY t.team_name
+		LIMIT $2 OFFSET $3`,
+		store.EscapeLike(filter.Prefix), filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []TeamSummary
+	for rows.Next() {
+		var t TeamSummary
+		if err := rows.Scan(&t.TeamName, &t.MembersCount, &t.ActiveMembersCount, &t.OpenPRsCount); err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// CountTeams возвращает количество команд, имя которых начинается с prefix
+func (r *Repository) CountTeams(ctx context.Context, prefix string) (int, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.store.GetConn().QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM teams WHERE team_name LIKE $1 || '%'",
+		store.EscapeLike(prefix)).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
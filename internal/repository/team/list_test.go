@@ -0,0 +1,115 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ListTeams(t *testing.T) {
+	tests := []struct {
+		name           string
+		filter         TeamListFilter
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []TeamSummary
+		expectedError  error
+	}{
+		{
+			name:   "successful list",
+			filter: TeamListFilter{Limit: 50},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"team_name", "members_count", "active_members_count", "open_prs_count"}).
+					AddRow("backend", 3, 2, 4).
+					AddRow("empty-team", 0, 0, 0)
+				mock.ExpectQuery(`SELECT\s+t.team_name,.+open_prs_count\s+FROM teams t\s+LEFT JOIN team_members tm ON tm.team_name = t.team_name\s+LEFT JOIN users u.+LIMIT \$2 OFFSET \$3`).
+					WithArgs("", 50, 0).
+					WillReturnRows(rows)
+			},
+			expectedResult: []TeamSummary{
+				{TeamName: "backend", MembersCount: 3, ActiveMembersCount: 2, OpenPRsCount: 4},
+				{TeamName: "empty-team", MembersCount: 0, ActiveMembersCount: 0, OpenPRsCount: 0},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "prefix is escaped",
+			filter: TeamListFilter{Prefix: "back_end%", Limit: 10, Offset: 20},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE t.team_name LIKE \$1 \|\| '%'`).
+					WithArgs(`back\_end\%`, 10, 20).
+					WillReturnRows(sqlmock.NewRows([]string{"team_name", "members_count", "active_members_count", "open_prs_count"}))
+			},
+			expectedResult: nil,
+			expectedError:  nil,
+		},
+		{
+			name:   "database error",
+			filter: TeamListFilter{Limit: 50},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT\s+t.team_name`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			repo := NewRepository(store)
+
+			teams, err := repo.ListTeams(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, teams)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, teams)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRepository_CountTeams(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM teams WHERE team_name LIKE \$1 \|\| '%'`).
+		WithArgs("back").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	store := store.New()
+	store.SetConn(db)
+
+	count, err := NewRepository(store).CountTeams(context.Background(), "back")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -3,50 +3,210 @@ package team
 import (
 	"context"
 	"database/sql"
+	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Transaction обертка над *sql.Tx для реализации Tx
 type Transaction struct {
-	tx *sql.Tx
+	tx   *sql.Tx
+	span trace.Span
 }
 
-// BeginTx начинает транзакцию
+// BeginTx начинает транзакцию и span, который длится до Commit или Rollback.
+// QueryTimeout здесь не применяется: отмена контекста откатила бы транзакцию целиком,
+// временем жизни транзакции управляет контекст вызывающего.
 func (r *Repository) BeginTx(ctx context.Context) (Tx, error) {
+	ctx, span := tracing.StartDB(ctx, "team.Repository.Transaction")
 	tx, err := r.store.GetConn().BeginTx(ctx, nil)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "begin transaction")
+		span.End()
 		return nil, err
 	}
-	return &Transaction{tx: tx}, nil
+	return &Transaction{tx: tx, span: span}, nil
 }
 
 // CreateTeam создает команду в транзакции
 func (t *Transaction) CreateTeam(teamName string) error {
 	_, err := t.tx.Exec("INSERT INTO teams (team_name) VALUES ($1)", teamName)
-	return err
+	return store.TranslateDBErr(err)
 }
 
 // CreateUser создает пользователя в транзакции
-func (t *Transaction) CreateUser(userID, username, teamName string, isActive bool) error {
+func (t *Transaction) CreateUser(userID, username string, isActive bool) error {
 	_, err := t.tx.Exec(
-		"INSERT INTO users (user_id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-		userID, username, teamName, isActive)
-	return err
+		"INSERT INTO users (user_id, username, is_active) VALUES ($1, $2, $3)",
+		userID, username, isActive)
+	return store.TranslateDBErr(err)
 }
 
 // UpdateUser обновляет пользователя в транзакции
-func (t *Transaction) UpdateUser(userID, username, teamName string, isActive bool) error {
+func (t *Transaction) UpdateUser(userID, username string, isActive bool) error {
+	_, err := t.tx.Exec(
+		"UPDATE users SET username = $1, is_active = $2 WHERE user_id = $3",
+		username, isActive, userID)
+	return err
+}
+
+// AddMember добавляет пользователя в команду; для существующего участника обновляет роль
+func (t *Transaction) AddMember(teamName, userID, role string) error {
+	_, err := t.tx.Exec(
+		`INSERT INTO team_members (team_name, user_id, role) VALUES ($1, $2, $3)
+		 ON CONFLICT (team_name, user_id) DO UPDATE SET role = EXCLUDED.role`,
+		teamName, userID, role)
+	return store.TranslateDBErr(err)
+}
+
+// RemoveMembers исключает пользователей из команды
+func (t *Transaction) RemoveMembers(teamName string, userIDs []string) error {
+	_, err := t.tx.Exec(
+		"DELETE FROM team_members WHERE team_name = $1 AND user_id = ANY($2)",
+		teamName, pq.Array(userIDs))
+	return err
+}
+
+// LockTeam блокирует строку команды до конца транзакции
+func (t *Transaction) LockTeam(teamName string) (bool, error) {
+	var name string
+	err := t.tx.QueryRow("SELECT team_name FROM teams WHERE team_name = $1 FOR UPDATE", teamName).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetTeamMembers возвращает участников команды
+func (t *Transaction) GetTeamMembers(teamName string) ([]User, error) {
+	rows, err := t.tx.Query(
+		`SELECT u.user_id, u.username, tm.role, u.is_active
+		 FROM team_members tm
+		 INNER JOIN users u ON u.user_id = tm.user_id
+		 WHERE tm.team_name = $1
+		 ORDER BY u.user_id`,
+		teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		u := User{TeamName: teamName}
+		if err := rows.Scan(&u.UserID, &u.Username, &u.Role, &u.IsActive); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetUsers возвращает существующих пользователей из userIDs и блокирует их строки
+func (t *Transaction) GetUsers(userIDs []string) ([]User, error) {
+	rows, err := t.tx.Query(
+		"SELECT user_id, username, is_active FROM users WHERE user_id = ANY($1) ORDER BY user_id FOR UPDATE",
+		pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.UserID, &u.Username, &u.IsActive); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// RenameTeam переименовывает команду; team_members и pullrequests обновляются через ON UPDATE CASCADE
+func (t *Transaction) RenameTeam(teamName, newTeamName string) error {
+	_, err := t.tx.Exec("UPDATE teams SET team_name = $1 WHERE team_name = $2", newTeamName, teamName)
+	return store.TranslateDBErr(err)
+}
+
+// DeleteTeam удаляет команду; членства удаляются каскадно, у PR команда обнуляется
+func (t *Transaction) DeleteTeam(teamName string) error {
+	_, err := t.tx.Exec("DELETE FROM teams WHERE team_name = $1", teamName)
+	return err
+}
+
+// GetOpenReviews возвращает открытые PR с любым из reviewerIDs и блокирует их строки
+func (t *Transaction) GetOpenReviews(reviewerIDs []string) ([]OpenReview, error) {
+	rows, err := t.tx.Query(`
+		SELECT pull_request_id, author_id, COALESCE(team_name, ''), assigned_reviewers
+		FROM pullrequests
+		WHERE status = 'OPEN' AND assigned_reviewers && $1
+		ORDER BY pull_request_id
+		FOR UPDATE`,
+		pq.Array(reviewerIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []OpenReview
+	for rows.Next() {
+		var review OpenReview
+		var reviewers pq.StringArray
+		if err := rows.Scan(&review.PullRequestID, &review.AuthorID, &review.TeamName, &reviewers); err != nil {
+			return nil, err
+		}
+		review.AssignedReviewers = []string(reviewers)
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// UpdateReviewers заменяет список ревьюверов PR и увеличивает его версию, чтобы изменения,
+// прочитавшие прежнюю версию (If-Match, переназначение), не перезаписали его
+func (t *Transaction) UpdateReviewers(pullRequestID string, reviewers []string) error {
 	_, err := t.tx.Exec(
-		"UPDATE users SET username = $1, team_name = $2, is_active = $3 WHERE user_id = $4",
-		username, teamName, isActive, userID)
+		"UPDATE pullrequests SET assigned_reviewers = $1, version = version + 1 WHERE pull_request_id = $2",
+		pq.Array(reviewers), pullRequestID)
 	return err
 }
 
 // Commit коммитит транзакцию
 func (t *Transaction) Commit() error {
-	return t.tx.Commit()
+	defer t.span.End()
+	err := t.tx.Commit()
+	if err != nil {
+		t.span.RecordError(err)
+		t.span.SetStatus(codes.Error, "commit")
+	}
+	return err
 }
 
-// Rollback откатывает транзакцию
+// Rollback откатывает транзакцию; после Commit ничего не делает
 func (t *Transaction) Rollback() error {
+	defer t.span.End()
 	return t.tx.Rollback()
 }
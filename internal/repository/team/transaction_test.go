@@ -0,0 +1,156 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTx(t *testing.T) (Tx, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store := store.New()
+	store.SetConn(db)
+
+	mock.ExpectBegin()
+	tx, err := NewRepository(store).BeginTx(context.Background())
+	if err != nil {
+		t.Fatalf("failed to begin tx: %v", err)
+	}
+
+	return tx, mock
+}
+
+func TestTransaction_LockTeam(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name: "team exists",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT team_name FROM teams WHERE team_name = \$1 FOR UPDATE`).
+					WithArgs("backend").
+					WillReturnRows(sqlmock.NewRows([]string{"team_name"}).AddRow("backend"))
+			},
+			expectedResult: true,
+		},
+		{
+			name: "team does not exist",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT team_name FROM teams`).
+					WithArgs("backend").
+					WillReturnRows(sqlmock.NewRows([]string{"team_name"}))
+			},
+			expectedResult: false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT team_name FROM teams`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedError: errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, mock := newTestTx(t)
+			tt.setupMock(mock)
+
+			exists, err := tx.LockTeam("backend")
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, exists)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_GetOpenReviews(t *testing.T) {
+	tx, mock := newTestTx(t)
+
+	rows := sqlmock.NewRows([]string{"pull_request_id", "author_id", "team_name", "assigned_reviewers"}).
+		AddRow("pr-1", "u1", "backend", "{u2,u3}").
+		AddRow("pr-2", "u9", "", "{u2}")
+	mock.ExpectQuery(`SELECT pull_request_id, author_id, COALESCE\(team_name, ''\), assigned_reviewers\s+FROM pullrequests\s+WHERE status = 'OPEN' AND assigned_reviewers && \$1.+FOR UPDATE`).
+		WithArgs(pq.Array([]string{"u2"})).
+		WillReturnRows(rows)
+
+	reviews, err := tx.GetOpenReviews([]string{"u2"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []OpenReview{
+		{PullRequestID: "pr-1", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2", "u3"}},
+		{PullRequestID: "pr-2", AuthorID: "u9", TeamName: "", AssignedReviewers: []string{"u2"}},
+	}, reviews)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestTransaction_UpdateReviewers(t *testing.T) {
+	tx, mock := newTestTx(t)
+
+	mock.ExpectExec(`UPDATE pullrequests SET assigned_reviewers = \$1, version = version \+ 1 WHERE pull_request_id = \$2`).
+		WithArgs(pq.Array([]string{"u3"}), "pr-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := tx.UpdateReviewers("pr-1", []string{"u3"})
+
+	assert.NoError(t, err)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestTransaction_AddMember(t *testing.T) {
+	tx, mock := newTestTx(t)
+
+	mock.ExpectExec(`INSERT INTO team_members \(team_name, user_id, role\) VALUES \(\$1, \$2, \$3\)\s+ON CONFLICT \(team_name, user_id\) DO UPDATE SET role = EXCLUDED.role`).
+		WithArgs("backend", "u2", "lead").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := tx.AddMember("backend", "u2", "lead")
+
+	assert.NoError(t, err)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestTransaction_RemoveMembers(t *testing.T) {
+	tx, mock := newTestTx(t)
+
+	mock.ExpectExec(`DELETE FROM team_members WHERE team_name = \$1 AND user_id = ANY\(\$2\)`).
+		WithArgs("backend", pq.Array([]string{"u2"})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := tx.RemoveMembers("backend", []string{"u2"})
+
+	assert.NoError(t, err)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -5,9 +5,31 @@ type Tx interface {
 	// CreateTeam создает команду в транзакции
 	CreateTeam(teamName string) error
 	// CreateUser создает пользователя в транзакции
-	CreateUser(userID, username, teamName string, isActive bool) error
+	CreateUser(userID, username string, isActive bool) error
 	// UpdateUser обновляет пользователя в транзакции
-	UpdateUser(userID, username, teamName string, isActive bool) error
+	UpdateUser(userID, username string, isActive bool) error
+	// AddMember добавляет пользователя в команду или меняет его роль
+	AddMember(teamName, userID, role string) error
+	// RemoveMembers исключает пользователей из команды
+	RemoveMembers(teamName string, userIDs []string) error
+	// LockTeam блокирует команду до конца транзакции; false, если команды нет
+	LockTeam(teamName string) (bool, error)
+	// LockTeams блокирует существующие команды из teamNames и возвращает их имена
+	LockTeams(teamNames []string) ([]string, error)
+	// GetMemberships возвращает членства пользователей userIDs и участников команд teamNames
+	GetMemberships(userIDs, teamNames []string) ([]User, error)
+	// GetTeamMembers возвращает участников команды
+	GetTeamMembers(teamName string) ([]User, error)
+	// GetUsers возвращает существующих пользователей из userIDs (без команд)
+	GetUsers(userIDs []string) ([]User, error)
+	// RenameTeam переименовывает команду, участники и PR обновляются каскадно
+	RenameTeam(teamName, newTeamName string) error
+	// DeleteTeam удаляет команду вместе с членствами
+	DeleteTeam(teamName string) error
+	// GetOpenReviews возвращает открытые PR, где ревьювером назначен кто-то из reviewerIDs
+	GetOpenReviews(reviewerIDs []string) ([]OpenReview, error)
+	// UpdateReviewers заменяет список ревьюверов PR и увеличивает его версию
+	UpdateReviewers(pullRequestID string, reviewers []string) error
 	// Commit коммитит транзакцию
 	Commit() error
 	// Rollback откатывает транзакцию
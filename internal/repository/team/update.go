@@ -2,9 +2,12 @@ package team
 
 import "context"
 
-func (r *Repository) UpdateUser(ctx context.Context, userID, username, teamName string, isActive bool) error {
+func (r *Repository) UpdateUser(ctx context.Context, userID, username string, isActive bool) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	_, err := r.store.GetConn().ExecContext(ctx,
-		"UPDATE users SET username = $1, team_name = $2, is_active = $3 WHERE user_id = $4",
-		username, teamName, isActive, userID)
+		"UPDATE users SET username = $1, is_active = $2 WHERE user_id = $3",
+		username, isActive, userID)
 	return err
 }
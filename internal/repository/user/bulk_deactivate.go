@@ -5,10 +5,14 @@ import (
 )
 
 func (r *Repository) BulkDeactivateTeamUsers(ctx context.Context, teamName string) ([]string, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET is_active = FALSE
-		WHERE team_name = $1 AND is_active = TRUE
+		WHERE user_id IN (SELECT user_id FROM team_members WHERE team_name = $1)
+		  AND is_active = TRUE
 		RETURNING user_id
 	`
 
@@ -1,15 +1,42 @@
 package user
 
+import "time"
+
+// User пользователь; TeamName - основная (первая по имени) из команд TeamNames
 type User struct {
+	UserID    string
+	Username  string
+	TeamName  string
+	TeamNames []string
+	Expertise []string
+	IsActive  bool
+}
+
+// UserListFilter фильтр и страница списка пользователей; пустые поля не фильтруют
+type UserListFilter struct {
+	TeamName       string
+	IsActive       *bool
+	UsernamePrefix string
+	Limit          int
+	Offset         int
+}
+
+// ReviewFilter фильтр и страница PR, в которых пользователь назначен ревьювером
+type ReviewFilter struct {
 	UserID   string
-	Username string
-	TeamName string
-	IsActive bool
+	Statuses []string
+	Desc     bool
+	Limit    int
+	Offset   int
 }
 
+// PullRequestShort PR в очереди ревью пользователя; CoReviewers - остальные ревьюверы PR
 type PullRequestShort struct {
 	PullRequestID   string
 	PullRequestName string
 	AuthorID        string
+	AuthorUsername  string
 	Status          string
+	CoReviewers     []string
+	CreatedAt       *time.Time
 }
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
+)
+
+// SetExpertise заменяет теги экспертизы пользователя на tags
+func (r *Repository) SetExpertise(ctx context.Context, userID string, tags []string) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.store.GetConn().ExecContext(ctx,
+		`WITH removed AS (
+			DELETE FROM user_expertise WHERE user_id = $1 AND NOT (tag = ANY($2))
+		)
+		INSERT INTO user_expertise (user_id, tag)
+		SELECT $1, tag FROM unnest($2::text[]) AS tag
+		ON CONFLICT (user_id, tag) DO NOTHING`,
+		userID, pq.Array(tags))
+	return store.TranslateDBErr(err)
+}
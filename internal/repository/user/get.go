@@ -3,14 +3,23 @@ package user
 import (
 	"context"
 	"database/sql"
+
+	"github.com/lib/pq"
 )
 
 func (r *Repository) GetUser(ctx context.Context, userID string) (User, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	var user User
+	var teamNames, expertise pq.StringArray
 
 	err := r.store.GetConn().QueryRowContext(ctx,
-		"SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1",
-		userID).Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive)
+		`SELECT u.user_id, u.username, u.is_active,
+			ARRAY(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name),
+			ARRAY(SELECT ue.tag FROM user_expertise ue WHERE ue.user_id = u.user_id ORDER BY ue.tag)
+		 FROM users u WHERE u.user_id = $1`,
+		userID).Scan(&user.UserID, &user.Username, &user.IsActive, &teamNames, &expertise)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return User{}, sql.ErrNoRows
@@ -18,5 +27,11 @@ func (r *Repository) GetUser(ctx context.Context, userID string) (User, error) {
 		return User{}, err
 	}
 
+	user.TeamNames = []string(teamNames)
+	user.Expertise = []string(expertise)
+	if len(user.TeamNames) > 0 {
+		user.TeamName = user.TeamNames[0]
+	}
+
 	return user, nil
 }
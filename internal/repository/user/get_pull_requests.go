@@ -2,14 +2,34 @@ package user
 
 import (
 	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
 )
 
-func (r *Repository) GetUserPullRequests(ctx context.Context, userID string) ([]PullRequestShort, error) {
+// reviewWhere условие выборки PR по ReviewFilter: $1 - ревьювер, $2 - статусы
+const reviewWhere = `
+	WHERE pr.assigned_reviewers @> ARRAY[$1]::text[]
+		AND pr.status = ANY($2)`
+
+// GetUserPullRequests возвращает страницу PR, где пользователь назначен ревьювером, по возрасту PR
+func (r *Repository) GetUserPullRequests(ctx context.Context, filter ReviewFilter) ([]PullRequestShort, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	orderBy := "pr.created_at ASC, pr.pull_request_id ASC"
+	if filter.Desc {
+		orderBy = "pr.created_at DESC, pr.pull_request_id DESC"
+	}
+
 	rows, err := r.store.GetConn().QueryContext(ctx,
-		`SELECT pull_request_id, pull_request_name, author_id, status 
-		 FROM pullrequests 
-		 WHERE $1 = ANY(assigned_reviewers)`,
-		userID)
+		`SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, COALESCE(u.username, ''), pr.status,
+			array_remove(pr.assigned_reviewers, $1), pr.created_at
+		 FROM pullrequests pr
+		 LEFT JOIN users u ON u.user_id = pr.author_id`+reviewWhere+`
+		 ORDER BY `+orderBy+`
+		 LIMIT $3 OFFSET $4`,
+		filter.UserID, pq.Array(filter.Statuses), filter.Limit, filter.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -18,9 +38,13 @@ func (r *Repository) GetUserPullRequests(ctx context.Context, userID string) ([]
 	var prs []PullRequestShort
 	for rows.Next() {
 		var pr PullRequestShort
-		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status); err != nil {
+		var coReviewers pq.StringArray
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.AuthorUsername, &pr.Status,
+			&coReviewers, &pr.CreatedAt); err != nil {
 			return nil, err
 		}
+		pr.CoReviewers = []string(coReviewers)
+		pr.CreatedAt = store.FromTimestamp(pr.CreatedAt)
 		prs = append(prs, pr)
 	}
 
@@ -30,3 +54,18 @@ func (r *Repository) GetUserPullRequests(ctx context.Context, userID string) ([]
 
 	return prs, nil
 }
+
+// CountUserPullRequests возвращает количество PR под фильтром без учёта страницы
+func (r *Repository) CountUserPullRequests(ctx context.Context, filter ReviewFilter) (int, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.store.GetConn().QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM pullrequests pr`+reviewWhere,
+		filter.UserID, pq.Array(filter.Statuses)).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
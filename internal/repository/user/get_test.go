@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/aabbuukkaarr8/PRService/internal/store"
@@ -23,17 +24,19 @@ func TestRepository_GetUser(t *testing.T) {
 			name:  "successful get",
 			userID: "user-001",
 			setupMock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-					AddRow("user-001", "alice", "backend", true)
-				mock.ExpectQuery(`SELECT user_id, username, team_name, is_active FROM users WHERE user_id`).
+				rows := sqlmock.NewRows([]string{"user_id", "username", "is_active", "team_names", "expertise"}).
+					AddRow("user-001", "alice", true, "{backend,devops}", "{go,postgres}")
+				mock.ExpectQuery(`SELECT u.user_id, u.username, u.is_active,\s+ARRAY\(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name\),\s+ARRAY\(SELECT ue.tag FROM user_expertise ue WHERE ue.user_id = u.user_id ORDER BY ue.tag\)\s+FROM users u WHERE u.user_id`).
 					WithArgs("user-001").
 					WillReturnRows(rows)
 			},
 			expectedResult: User{
-				UserID:   "user-001",
-				Username: "alice",
-				TeamName: "backend",
-				IsActive: true,
+				UserID:    "user-001",
+				Username:  "alice",
+				TeamName:  "backend",
+				TeamNames: []string{"backend", "devops"},
+				Expertise: []string{"go", "postgres"},
+				IsActive:  true,
 			},
 			expectedError: nil,
 		},
@@ -41,7 +44,7 @@ func TestRepository_GetUser(t *testing.T) {
 			name:  "user not found",
 			userID: "user-999",
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT user_id, username, team_name, is_active FROM users WHERE user_id`).
+				mock.ExpectQuery(`SELECT u.user_id, u.username, u.is_active,\s+ARRAY\(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name\),\s+ARRAY\(SELECT ue.tag FROM user_expertise ue WHERE ue.user_id = u.user_id ORDER BY ue.tag\)\s+FROM users u WHERE u.user_id`).
 					WithArgs("user-999").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -52,7 +55,7 @@ func TestRepository_GetUser(t *testing.T) {
 			name:  "database error",
 			userID: "user-001",
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT user_id, username, team_name, is_active FROM users WHERE user_id`).
+				mock.ExpectQuery(`SELECT u.user_id, u.username, u.is_active,\s+ARRAY\(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name\),\s+ARRAY\(SELECT ue.tag FROM user_expertise ue WHERE ue.user_id = u.user_id ORDER BY ue.tag\)\s+FROM users u WHERE u.user_id`).
 					WithArgs("user-001").
 					WillReturnError(errors.New("database connection error"))
 			},
@@ -63,17 +66,19 @@ func TestRepository_GetUser(t *testing.T) {
 			name:  "successful get with inactive user",
 			userID: "user-002",
 			setupMock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-					AddRow("user-002", "bob", "frontend", false)
-				mock.ExpectQuery(`SELECT user_id, username, team_name, is_active FROM users WHERE user_id`).
+				rows := sqlmock.NewRows([]string{"user_id", "username", "is_active", "team_names", "expertise"}).
+					AddRow("user-002", "bob", false, "{frontend}", "{}")
+				mock.ExpectQuery(`SELECT u.user_id, u.username, u.is_active,\s+ARRAY\(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name\),\s+ARRAY\(SELECT ue.tag FROM user_expertise ue WHERE ue.user_id = u.user_id ORDER BY ue.tag\)\s+FROM users u WHERE u.user_id`).
 					WithArgs("user-002").
 					WillReturnRows(rows)
 			},
 			expectedResult: User{
-				UserID:   "user-002",
-				Username: "bob",
-				TeamName: "frontend",
-				IsActive: false,
+				UserID:    "user-002",
+				Username:  "bob",
+				TeamName:  "frontend",
+				TeamNames: []string{"frontend"},
+				Expertise: []string{},
+				IsActive:  false,
 			},
 			expectedError: nil,
 		},
@@ -110,6 +115,8 @@ func TestRepository_GetUser(t *testing.T) {
 				assert.Equal(t, tt.expectedResult.UserID, result.UserID)
 				assert.Equal(t, tt.expectedResult.Username, result.Username)
 				assert.Equal(t, tt.expectedResult.TeamName, result.TeamName)
+				assert.Equal(t, tt.expectedResult.TeamNames, result.TeamNames)
+				assert.Equal(t, tt.expectedResult.Expertise, result.Expertise)
 				assert.Equal(t, tt.expectedResult.IsActive, result.IsActive)
 			}
 
@@ -121,67 +128,48 @@ func TestRepository_GetUser(t *testing.T) {
 }
 
 func TestRepository_GetUserPullRequests(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	columns := []string{"pull_request_id", "pull_request_name", "author_id", "username", "status", "co_reviewers", "created_at"}
+
 	tests := []struct {
 		name           string
-		userID          string
+		filter         ReviewFilter
 		setupMock      func(mock sqlmock.Sqlmock)
 		expectedResult []PullRequestShort
 		expectedError  error
 	}{
 		{
-			name:  "successful get with multiple PRs",
-			userID: "user-001",
+			name:   "oldest first",
+			filter: ReviewFilter{UserID: "u2", Statuses: []string{"OPEN"}, Limit: 50},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status"}).
-					AddRow("pr-001", "Test PR 1", "user-002", "OPEN").
-					AddRow("pr-002", "Test PR 2", "user-003", "MERGED")
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status`).
-					WithArgs("user-001").
+				rows := sqlmock.NewRows(columns).
+					AddRow("pr-001", "PR 1", "u1", "alice", "OPEN", "{u3}", createdAt)
+				mock.ExpectQuery(`FROM pullrequests pr\s+LEFT JOIN users u ON u.user_id = pr.author_id\s+WHERE pr.assigned_reviewers @> ARRAY\[\$1\]::text\[\]\s+AND pr.status = ANY\(\$2\)\s+ORDER BY pr.created_at ASC, pr.pull_request_id ASC\s+LIMIT \$3 OFFSET \$4`).
+					WithArgs("u2", sqlmock.AnyArg(), 50, 0).
 					WillReturnRows(rows)
 			},
 			expectedResult: []PullRequestShort{
-				{PullRequestID: "pr-001", PullRequestName: "Test PR 1", AuthorID: "user-002", Status: "OPEN"},
-				{PullRequestID: "pr-002", PullRequestName: "Test PR 2", AuthorID: "user-003", Status: "MERGED"},
+				{PullRequestID: "pr-001", PullRequestName: "PR 1", AuthorID: "u1", AuthorUsername: "alice", Status: "OPEN", CoReviewers: []string{"u3"}, CreatedAt: store.FromTimestamp(&createdAt)},
 			},
-			expectedError: nil,
 		},
 		{
-			name:  "successful get with single PR",
-			userID: "user-002",
+			name:   "newest first",
+			filter: ReviewFilter{UserID: "u2", Statuses: []string{"OPEN", "MERGED"}, Desc: true, Limit: 10, Offset: 10},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status"}).
-					AddRow("pr-003", "Test PR 3", "user-001", "OPEN")
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status`).
-					WithArgs("user-002").
-					WillReturnRows(rows)
+				mock.ExpectQuery(`ORDER BY pr.created_at DESC, pr.pull_request_id DESC`).
+					WithArgs("u2", sqlmock.AnyArg(), 10, 10).
+					WillReturnRows(sqlmock.NewRows(columns))
 			},
-			expectedResult: []PullRequestShort{
-				{PullRequestID: "pr-003", PullRequestName: "Test PR 3", AuthorID: "user-001", Status: "OPEN"},
-			},
-			expectedError: nil,
-		},
-		{
-			name:  "successful get with no PRs",
-			userID: "user-003",
-			setupMock: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status"})
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status`).
-					WithArgs("user-003").
-					WillReturnRows(rows)
-			},
-			expectedResult: []PullRequestShort{},
-			expectedError:  nil,
+			expectedResult: nil,
 		},
 		{
-			name:  "database error",
-			userID: "user-001",
+			name:   "database error",
+			filter: ReviewFilter{UserID: "u2", Limit: 50},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT pull_request_id, pull_request_name, author_id, status`).
-					WithArgs("user-001").
+				mock.ExpectQuery(`SELECT pr.pull_request_id`).
 					WillReturnError(errors.New("database connection error"))
 			},
-			expectedResult: nil,
-			expectedError:  errors.New("database connection error"),
+			expectedError: errors.New("database connection error"),
 		},
 	}
 
@@ -198,24 +186,15 @@ func TestRepository_GetUserPullRequests(t *testing.T) {
 			store := store.New()
 			store.SetConn(db)
 
-			repo := NewRepository(store)
-
-			ctx := context.Background()
-			result, err := repo.GetUserPullRequests(ctx, tt.userID)
+			prs, err := NewRepository(store).GetUserPullRequests(context.Background(), tt.filter)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.expectedError.Error())
-				assert.Nil(t, result)
+				assert.Nil(t, prs)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, len(tt.expectedResult), len(result))
-				for i, expectedPR := range tt.expectedResult {
-					assert.Equal(t, expectedPR.PullRequestID, result[i].PullRequestID)
-					assert.Equal(t, expectedPR.PullRequestName, result[i].PullRequestName)
-					assert.Equal(t, expectedPR.AuthorID, result[i].AuthorID)
-					assert.Equal(t, expectedPR.Status, result[i].Status)
-				}
+				assert.Equal(t, tt.expectedResult, prs)
 			}
 
 			if err := mock.ExpectationsWereMet(); err != nil {
@@ -225,6 +204,27 @@ func TestRepository_GetUserPullRequests(t *testing.T) {
 	}
 }
 
+func TestRepository_CountUserPullRequests(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM pullrequests pr\s+WHERE pr.assigned_reviewers @> ARRAY\[\$1\]::text\[\]`).
+		WithArgs("u2", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	store := store.New()
+	store.SetConn(db)
+
+	count, err := NewRepository(store).CountUserPullRequests(context.Background(), ReviewFilter{UserID: "u2", Statuses: []string{"OPEN"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_UpdateUserIsActive(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/lib/pq"
+)
+
+// userListWhere условие выборки пользователей по UserListFilter: $1 - команда,
+// $2 - активность, $3 - экранированный префикс имени
+const userListWhere = `
+	WHERE ($1 = '' OR EXISTS (SELECT 1 FROM team_members tm WHERE tm.user_id = u.user_id AND tm.team_name = $1))
+		AND ($2::boolean IS NULL OR u.is_active = $2)
+		AND u.username LIKE $3 || '%'`
+
+// ListUsers возвращает страницу пользователей, упорядоченных по имени, вместе с их командами
+func (r *Repository) ListUsers(ctx context.Context, filter UserListFilter) ([]User, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.store.GetConn().QueryContext(ctx,
+		`SELECT u.user_id, u.username, u.is_active,
+			ARRAY(SELECT tm.team_name FROM team_members tm WHERE tm.user_id = u.user_id ORDER BY tm.team_name),
+			ARRAY(SELECT ue.tag FROM user_expertise ue WHERE ue.user_id = u.user_id ORDER BY ue.tag)
+		 FROM users u`+userListWhere+`
+		 ORDER BY u.username, u.user_id
+		 LIMIT $4 OFFSET $5`,
+		filter.TeamName, filter.IsActive, store.EscapeLike(filter.UsernamePrefix), filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var user User
+		var teamNames, expertise pq.StringArray
+		if err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &teamNames, &expertise); err != nil {
+			return nil, err
+		}
+
+		user.TeamNames = []string(teamNames)
+		user.Expertise = []string(expertise)
+		if len(user.TeamNames) > 0 {
+			user.TeamName = user.TeamNames[0]
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// CountUsers возвращает количество пользователей под фильтром без учёта страницы
+func (r *Repository) CountUsers(ctx context.Context, filter UserListFilter) (int, error) {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	var count int
+	err := r.store.GetConn().QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM users u`+userListWhere,
+		filter.TeamName, filter.IsActive, store.EscapeLike(filter.UsernamePrefix)).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,139 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_ListUsers(t *testing.T) {
+	active := true
+
+	tests := []struct {
+		name           string
+		filter         UserListFilter
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedResult []User
+		expectedError  error
+	}{
+		{
+			name:   "successful list without filters",
+			filter: UserListFilter{Limit: 50},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"user_id", "username", "is_active", "team_names", "expertise"}).
+					AddRow("user-001", "alice", true, "{backend,devops}", "{go,postgres}").
+					AddRow("user-002", "bob", false, "{}", "{}")
+				mock.ExpectQuery(`SELECT u.user_id, u.username, u.is_active,.+FROM users u\s+WHERE .+ORDER BY u.username, u.user_id\s+LIMIT \$4 OFFSET \$5`).
+					WithArgs("", nil, "", 50, 0).
+					WillReturnRows(rows)
+			},
+			expectedResult: []User{
+				{UserID: "user-001", Username: "alice", TeamName: "backend", TeamNames: []string{"backend", "devops"}, Expertise: []string{"go", "postgres"}, IsActive: true},
+				{UserID: "user-002", Username: "bob", TeamNames: []string{}, Expertise: []string{}, IsActive: false},
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "filters are passed as arguments",
+			filter: UserListFilter{TeamName: "backend", IsActive: &active, UsernamePrefix: "al_", Limit: 10, Offset: 10},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`tm.team_name = \$1.+u.is_active = \$2.+u.username LIKE \$3 \|\| '%'`).
+					WithArgs("backend", true, `al\_`, 10, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "username", "is_active", "team_names", "expertise"}))
+			},
+			expectedResult: nil,
+			expectedError:  nil,
+		},
+		{
+			name:   "database error",
+			filter: UserListFilter{Limit: 50},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT u.user_id`).
+					WillReturnError(errors.New("database connection error"))
+			},
+			expectedResult: nil,
+			expectedError:  errors.New("database connection error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create mock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMock(mock)
+
+			store := store.New()
+			store.SetConn(db)
+
+			repo := NewRepository(store)
+
+			users, err := repo.ListUsers(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, users)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, users)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRepository_CountUsers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM users u\s+WHERE`).
+		WithArgs("backend", nil, "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+	store := store.New()
+	store.SetConn(db)
+
+	count, err := NewRepository(store).CountUsers(context.Background(), UserListFilter{TeamName: "backend", Limit: 50})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, count)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRepository_SetExpertise(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM user_expertise WHERE user_id = \$1 AND NOT \(tag = ANY\(\$2\)\).+INSERT INTO user_expertise \(user_id, tag\)`).
+		WithArgs("user-001", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	store := store.New()
+	store.SetConn(db)
+
+	err = NewRepository(store).SetExpertise(context.Background(), "user-001", []string{"go", "postgres"})
+
+	assert.NoError(t, err)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
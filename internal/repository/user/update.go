@@ -3,8 +3,21 @@ package user
 import "context"
 
 func (r *Repository) UpdateUserIsActive(ctx context.Context, userID string, isActive bool) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
 	_, err := r.store.GetConn().ExecContext(ctx,
 		"UPDATE users SET is_active = $1 WHERE user_id = $2",
 		isActive, userID)
 	return err
 }
+
+func (r *Repository) UpdateUsername(ctx context.Context, userID, username string) error {
+	ctx, cancel := r.store.WithQueryTimeout(ctx)
+	defer cancel()
+
+	_, err := r.store.GetConn().ExecContext(ctx,
+		"UPDATE users SET username = $1 WHERE user_id = $2",
+		username, userID)
+	return err
+}
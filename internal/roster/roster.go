@@ -0,0 +1,183 @@
+// Package roster разбирает список команд и участников (оргструктуру) из YAML или CSV
+// для массового импорта.
+package roster
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+const (
+	FormatYAML Format = "yaml"
+	FormatCSV  Format = "csv"
+)
+
+var ErrUnknownFormat = errors.New("unknown roster format")
+
+type Member struct {
+	UserID   string `yaml:"user_id" binding:"required,id"`
+	Username string `yaml:"username" binding:"required,name"`
+	IsActive *bool  `yaml:"is_active"`
+}
+
+type Team struct {
+	TeamName string   `yaml:"team_name" binding:"required,name"`
+	Members  []Member `yaml:"members" binding:"maxmembers,dive"`
+}
+
+// Roster список команд с участниками.
+//
+// YAML (JSON тоже подходит):
+//
+//	teams:
+//	  - team_name: backend
+//	    members:
+//	      - {user_id: u1, username: Alice}
+//	      - {user_id: u2, username: Bob, is_active: false}
+//
+// CSV с заголовком (колонка is_active необязательна):
+//
+//	team_name,user_id,username,is_active
+//	backend,u1,Alice,true
+type Roster struct {
+	Teams []Team `yaml:"teams" binding:"dive"`
+}
+
+// FormatFromContentType определяет формат по заголовку Content-Type
+func FormatFromContentType(contentType string) (Format, error) {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml", "application/json":
+		return FormatYAML, nil
+	case "text/csv":
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("%w: content type %q", ErrUnknownFormat, contentType)
+	}
+}
+
+// FormatFromFileName определяет формат по расширению файла
+func FormatFromFileName(name string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return FormatYAML, nil
+	case ".csv":
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("%w: file %q", ErrUnknownFormat, name)
+	}
+}
+
+func Parse(r io.Reader, format Format) (Roster, error) {
+	switch format {
+	case FormatYAML:
+		return parseYAML(r)
+	case FormatCSV:
+		return parseCSV(r)
+	default:
+		return Roster{}, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+func parseYAML(r io.Reader) (Roster, error) {
+	var roster Roster
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&roster); err != nil {
+		if errors.Is(err, io.EOF) {
+			return Roster{}, nil
+		}
+		return Roster{}, fmt.Errorf("invalid yaml roster: %w", err)
+	}
+	return roster, nil
+}
+
+func parseCSV(r io.Reader) (Roster, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return Roster{}, nil
+		}
+		return Roster{}, fmt.Errorf("invalid csv roster: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"team_name", "user_id", "username"} {
+		if _, ok := columns[required]; !ok {
+			return Roster{}, fmt.Errorf("invalid csv roster: missing column %q", required)
+		}
+	}
+	activeColumn, hasActive := columns["is_active"]
+
+	var roster Roster
+	teamIndex := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Roster{}, fmt.Errorf("invalid csv roster: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		member := Member{
+			UserID:   strings.TrimSpace(record[columns["user_id"]]),
+			Username: strings.TrimSpace(record[columns["username"]]),
+		}
+		if hasActive && strings.TrimSpace(record[activeColumn]) != "" {
+			isActive, err := strconv.ParseBool(strings.TrimSpace(record[activeColumn]))
+			if err != nil {
+				return Roster{}, fmt.Errorf("invalid csv roster: line %d: invalid is_active %q", line, record[activeColumn])
+			}
+			member.IsActive = &isActive
+		}
+
+		teamName := strings.TrimSpace(record[columns["team_name"]])
+		i, ok := teamIndex[teamName]
+		if !ok {
+			i = len(roster.Teams)
+			teamIndex[teamName] = i
+			roster.Teams = append(roster.Teams, Team{TeamName: teamName})
+		}
+		roster.Teams[i].Members = append(roster.Teams[i].Members, member)
+	}
+
+	return roster, nil
+}
+
+// ToService конвертирует roster в команды сервисного слоя; is_active по умолчанию true
+func (r Roster) ToService() []teamsrv.Team {
+	teams := make([]teamsrv.Team, len(r.Teams))
+	for i, t := range r.Teams {
+		members := make([]teamsrv.TeamMember, len(t.Members))
+		for j, m := range t.Members {
+			members[j] = teamsrv.TeamMember{
+				UserID:   m.UserID,
+				Username: m.Username,
+				IsActive: m.IsActive == nil || *m.IsActive,
+			}
+		}
+		teams[i] = teamsrv.Team{
+			TeamName: t.TeamName,
+			Members:  members,
+		}
+	}
+	return teams
+}
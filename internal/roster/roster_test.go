@@ -0,0 +1,162 @@
+package roster
+
+import (
+	"strings"
+	"testing"
+
+	teamsrv "github.com/aabbuukkaarr8/PRService/internal/service/team"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name           string
+		input          string
+		format         Format
+		expectedResult Roster
+		expectedError  string
+	}{
+		{
+			name: "yaml roster",
+			input: `teams:
+  - team_name: backend
+    members:
+      - {user_id: u1, username: Alice}
+      - {user_id: u2, username: Bob, is_active: false}
+  - team_name: frontend
+    members: []
+`,
+			format: FormatYAML,
+			expectedResult: Roster{Teams: []Team{
+				{TeamName: "backend", Members: []Member{
+					{UserID: "u1", Username: "Alice"},
+					{UserID: "u2", Username: "Bob", IsActive: &inactive},
+				}},
+				{TeamName: "frontend", Members: []Member{}},
+			}},
+		},
+		{
+			name:   "json roster",
+			input:  `{"teams": [{"team_name": "backend", "members": [{"user_id": "u1", "username": "Alice"}]}]}`,
+			format: FormatYAML,
+			expectedResult: Roster{Teams: []Team{
+				{TeamName: "backend", Members: []Member{{UserID: "u1", Username: "Alice"}}},
+			}},
+		},
+		{
+			name:          "yaml unknown field",
+			input:         "teams:\n  - team: backend\n",
+			format:        FormatYAML,
+			expectedError: "invalid yaml roster",
+		},
+		{
+			name: "csv roster groups rows by team",
+			input: `team_name,user_id,username,is_active
+backend,u1,Alice,true
+frontend,u3,Carol,
+backend,u2,Bob,false
+`,
+			format: FormatCSV,
+			expectedResult: Roster{Teams: []Team{
+				{TeamName: "backend", Members: []Member{
+					{UserID: "u1", Username: "Alice", IsActive: boolPtr(true)},
+					{UserID: "u2", Username: "Bob", IsActive: &inactive},
+				}},
+				{TeamName: "frontend", Members: []Member{
+					{UserID: "u3", Username: "Carol"},
+				}},
+			}},
+		},
+		{
+			name:   "csv without is_active column",
+			input:  "username,user_id,team_name\nAlice,u1,backend\n",
+			format: FormatCSV,
+			expectedResult: Roster{Teams: []Team{
+				{TeamName: "backend", Members: []Member{{UserID: "u1", Username: "Alice"}}},
+			}},
+		},
+		{
+			name:          "csv missing column",
+			input:         "team_name,user_id\nbackend,u1\n",
+			format:        FormatCSV,
+			expectedError: `missing column "username"`,
+		},
+		{
+			name:          "csv invalid is_active",
+			input:         "team_name,user_id,username,is_active\nbackend,u1,Alice,maybe\n",
+			format:        FormatCSV,
+			expectedError: "line 2: invalid is_active",
+		},
+		{
+			name:          "unknown format",
+			input:         "",
+			format:        Format("xml"),
+			expectedError: "unknown roster format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(strings.NewReader(tt.input), tt.format)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestFormatFromContentType(t *testing.T) {
+	tests := []struct {
+		contentType   string
+		expected      Format
+		expectedError bool
+	}{
+		{contentType: "application/yaml", expected: FormatYAML},
+		{contentType: "application/json; charset=utf-8", expected: FormatYAML},
+		{contentType: "text/csv", expected: FormatCSV},
+		{contentType: "text/plain", expectedError: true},
+		{contentType: "", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			format, err := FormatFromContentType(tt.contentType)
+
+			if tt.expectedError {
+				assert.ErrorIs(t, err, ErrUnknownFormat)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestRoster_ToService(t *testing.T) {
+	r := Roster{Teams: []Team{
+		{TeamName: "backend", Members: []Member{
+			{UserID: "u1", Username: "Alice"},
+			{UserID: "u2", Username: "Bob", IsActive: boolPtr(false)},
+		}},
+	}}
+
+	assert.Equal(t, []teamsrv.Team{
+		{TeamName: "backend", Members: []teamsrv.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: false},
+		}},
+	}, r.ToService())
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
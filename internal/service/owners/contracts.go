@@ -0,0 +1,16 @@
+package owners
+
+import (
+	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/owners"
+)
+
+type Repo interface {
+	ListRules(ctx context.Context) ([]owners.OwnershipRule, error)
+	CreateRule(ctx context.Context, rule owners.OwnershipRule) (owners.OwnershipRule, error)
+	ReplaceRules(ctx context.Context, rules []owners.OwnershipRule) ([]owners.OwnershipRule, error)
+	DeleteRule(ctx context.Context, ruleID int64) (bool, error)
+	GetExistingUsers(ctx context.Context, userIDs []string) ([]string, error)
+	GetExistingTeams(ctx context.Context, teamNames []string) ([]string, error)
+}
@@ -0,0 +1,51 @@
+package owners
+
+import "github.com/aabbuukkaarr8/PRService/internal/repository/owners"
+
+// Rule правило владения кодом: файлы под Pattern (синтаксис CODEOWNERS) принадлежат
+// пользователям UserIDs и участникам команд TeamNames
+type Rule struct {
+	RuleID    int64
+	Pattern   string
+	UserIDs   []string
+	TeamNames []string
+}
+
+func (r *Rule) FillFromDB(dbr *owners.OwnershipRule) {
+	r.RuleID = dbr.RuleID
+	r.Pattern = dbr.Pattern
+	r.UserIDs = dbr.UserIDs
+	r.TeamNames = dbr.TeamNames
+}
+
+func (r *Rule) ToDB() owners.OwnershipRule {
+	return owners.OwnershipRule{
+		RuleID:    r.RuleID,
+		Pattern:   r.Pattern,
+		UserIDs:   r.UserIDs,
+		TeamNames: r.TeamNames,
+	}
+}
+
+func rulesFromDB(dbRules []owners.OwnershipRule) []Rule {
+	rules := make([]Rule, len(dbRules))
+	for i := range dbRules {
+		rules[i].FillFromDB(&dbRules[i])
+	}
+	return rules
+}
+
+// PathOwners владельцы одного пути; Pattern пуст, если ни одно правило не назначает пути владельцев
+type PathOwners struct {
+	Path      string
+	Pattern   string
+	UserIDs   []string
+	TeamNames []string
+}
+
+// Resolution владельцы по каждому пути и их объединение без повторов
+type Resolution struct {
+	Paths     []PathOwners
+	UserIDs   []string
+	TeamNames []string
+}
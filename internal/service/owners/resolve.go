@@ -0,0 +1,67 @@
+package owners
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aabbuukkaarr8/PRService/internal/codeowners"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ResolveOwners определяет владельцев путей по сохраненным правилам
+func (s *Service) ResolveOwners(ctx context.Context, paths []string) (Resolution, error) {
+	ctx, span := tracing.Start(ctx, "owners.Service.ResolveOwners", attribute.Int("paths", len(paths)))
+	defer span.End()
+
+	dbRules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return Resolution{}, err
+	}
+	return resolve(rulesFromDB(dbRules), paths)
+}
+
+// ResolveOwnersWithRules определяет владельцев путей по переданным правилам (например, CODEOWNERS
+// из ветки PR), не сохраняя их. Правила проверяются так же, как при импорте
+func (s *Service) ResolveOwnersWithRules(ctx context.Context, rules []Rule, paths []string) (Resolution, error) {
+	ctx, span := tracing.Start(ctx, "owners.Service.ResolveOwnersWithRules", attribute.Int("paths", len(paths)))
+	defer span.End()
+
+	if err := s.validateRules(ctx, rules); err != nil {
+		return Resolution{}, err
+	}
+	return resolve(rules, paths)
+}
+
+// resolve сопоставляет пути с правилами: для каждого пути действует последнее совпавшее правило
+func resolve(rules []Rule, paths []string) (Resolution, error) {
+	patterns := make([]string, len(rules))
+	for i, rule := range rules {
+		patterns[i] = rule.Pattern
+	}
+	ruleset, err := codeowners.CompileRuleset(patterns)
+	if err != nil {
+		return Resolution{}, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+	}
+
+	result := Resolution{
+		Paths:     make([]PathOwners, len(paths)),
+		UserIDs:   []string{},
+		TeamNames: []string{},
+	}
+	for i, path := range paths {
+		owners := PathOwners{Path: path, UserIDs: []string{}, TeamNames: []string{}}
+		if j := ruleset.Match(path); j >= 0 {
+			owners.Pattern = rules[j].Pattern
+			owners.UserIDs = dedupe(rules[j].UserIDs)
+			owners.TeamNames = dedupe(rules[j].TeamNames)
+			result.UserIDs = append(result.UserIDs, owners.UserIDs...)
+			result.TeamNames = append(result.TeamNames, owners.TeamNames...)
+		}
+		result.Paths[i] = owners
+	}
+	result.UserIDs = dedupe(result.UserIDs)
+	result.TeamNames = dedupe(result.TeamNames)
+
+	return result, nil
+}
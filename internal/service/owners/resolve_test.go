@@ -0,0 +1,114 @@
+package owners
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/owners"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_ResolveOwners(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*mockRepo)
+		expectedError  error
+		expectedResult Resolution
+	}{
+		{
+			name: "last matching rule wins",
+			setupMock: func(m *mockRepo) {
+				m.On("ListRules", mock.Anything).Return([]owners.OwnershipRule{
+					{RuleID: 1, Pattern: "*", TeamNames: []string{"backend"}},
+					{RuleID: 2, Pattern: "/docs/", UserIDs: []string{"u2"}},
+					{RuleID: 3, Pattern: "!docs/drafts/"},
+				}, nil)
+			},
+			expectedResult: Resolution{
+				Paths: []PathOwners{
+					{Path: "main.go", Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}},
+					{Path: "docs/api.md", Pattern: "/docs/", UserIDs: []string{"u2"}, TeamNames: []string{}},
+					{Path: "docs/drafts/new.md", UserIDs: []string{}, TeamNames: []string{}},
+				},
+				UserIDs:   []string{"u2"},
+				TeamNames: []string{"backend"},
+			},
+		},
+		{
+			name: "no rules",
+			setupMock: func(m *mockRepo) {
+				m.On("ListRules", mock.Anything).Return([]owners.OwnershipRule{}, nil)
+			},
+			expectedResult: Resolution{
+				Paths: []PathOwners{
+					{Path: "main.go", UserIDs: []string{}, TeamNames: []string{}},
+					{Path: "docs/api.md", UserIDs: []string{}, TeamNames: []string{}},
+					{Path: "docs/drafts/new.md", UserIDs: []string{}, TeamNames: []string{}},
+				},
+				UserIDs:   []string{},
+				TeamNames: []string{},
+			},
+		},
+		{
+			name: "database error",
+			setupMock: func(m *mockRepo) {
+				m.On("ListRules", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedError:  errors.New("database error"),
+			expectedResult: Resolution{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.ResolveOwners(context.Background(), []string{"main.go", "docs/api.md", "docs/drafts/new.md"})
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResult, result)
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ResolveOwnersWithRules(t *testing.T) {
+	t.Run("owners are validated", func(t *testing.T) {
+		mockRepo := new(mockRepo)
+		mockRepo.On("GetExistingUsers", mock.Anything, []string{"ghost"}).Return([]string{}, nil)
+
+		service := &Service{repo: mockRepo}
+		_, err := service.ResolveOwnersWithRules(context.Background(),
+			[]Rule{{Pattern: "*", UserIDs: []string{"ghost"}}}, []string{"main.go"})
+
+		assert.ErrorIs(t, err, ErrUnknownOwner)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rules are not saved", func(t *testing.T) {
+		mockRepo := new(mockRepo)
+		mockRepo.On("GetExistingTeams", mock.Anything, []string{"backend"}).Return([]string{"backend"}, nil)
+
+		service := &Service{repo: mockRepo}
+		result, err := service.ResolveOwnersWithRules(context.Background(),
+			[]Rule{{Pattern: "*.go", TeamNames: []string{"backend"}}}, []string{"main.go", "README.md"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"backend"}, result.TeamNames)
+		assert.Equal(t, "", result.Paths[1].Pattern)
+		mockRepo.AssertExpectations(t)
+	})
+}
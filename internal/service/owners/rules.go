@@ -0,0 +1,163 @@
+package owners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/codeowners"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/owners"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrInvalidRule  = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid rule")
+	ErrUnknownOwner = apperr.New(apperr.KindNotFound, "NOT_FOUND", "unknown owners")
+	ErrRuleNotFound = apperr.New(apperr.KindNotFound, "NOT_FOUND", "rule not found")
+)
+
+func (s *Service) ListRules(ctx context.Context) ([]Rule, error) {
+	ctx, span := tracing.Start(ctx, "owners.Service.ListRules")
+	defer span.End()
+
+	dbRules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rulesFromDB(dbRules), nil
+}
+
+// CreateRule добавляет правило в конец списка: при совпадении оно перекрывает все предыдущие
+func (s *Service) CreateRule(ctx context.Context, rule Rule) (Rule, error) {
+	ctx, span := tracing.Start(ctx, "owners.Service.CreateRule")
+	defer span.End()
+
+	if err := s.validateRules(ctx, []Rule{rule}); err != nil {
+		return Rule{}, err
+	}
+
+	rule.UserIDs = dedupe(rule.UserIDs)
+	rule.TeamNames = dedupe(rule.TeamNames)
+
+	dbRule, err := s.repo.CreateRule(ctx, rule.ToDB())
+	if errors.Is(err, store.ErrForeignKeyViolated) {
+		// владелец удален после проверки
+		return Rule{}, ErrUnknownOwner
+	}
+	if err != nil {
+		return Rule{}, err
+	}
+
+	result := Rule{}
+	result.FillFromDB(&dbRule)
+	return result, nil
+}
+
+// ImportRules заменяет все правила на rules, порядок сохраняется
+func (s *Service) ImportRules(ctx context.Context, rules []Rule) ([]Rule, error) {
+	ctx, span := tracing.Start(ctx, "owners.Service.ImportRules")
+	defer span.End()
+
+	if err := s.validateRules(ctx, rules); err != nil {
+		return nil, err
+	}
+
+	dbRules := make([]owners.OwnershipRule, len(rules))
+	for i, rule := range rules {
+		rule.UserIDs = dedupe(rule.UserIDs)
+		rule.TeamNames = dedupe(rule.TeamNames)
+		dbRules[i] = rule.ToDB()
+	}
+
+	saved, err := s.repo.ReplaceRules(ctx, dbRules)
+	if errors.Is(err, store.ErrForeignKeyViolated) {
+		return nil, ErrUnknownOwner
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rulesFromDB(saved), nil
+}
+
+func (s *Service) DeleteRule(ctx context.Context, ruleID int64) error {
+	ctx, span := tracing.Start(ctx, "owners.Service.DeleteRule", attribute.Int64("rule_id", ruleID))
+	defer span.End()
+
+	deleted, err := s.repo.DeleteRule(ctx, ruleID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrRuleNotFound
+	}
+	return nil
+}
+
+// validateRules проверяет шаблоны, отсутствие владельцев у отрицаний и существование всех владельцев
+func (s *Service) validateRules(ctx context.Context, rules []Rule) error {
+	var userIDs, teamNames []string
+	for _, rule := range rules {
+		pattern, err := codeowners.CompilePattern(rule.Pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+		if pattern.Negated() && len(rule.UserIDs)+len(rule.TeamNames) > 0 {
+			return fmt.Errorf("%w: %q, negated pattern cannot have owners", ErrInvalidRule, rule.Pattern)
+		}
+		userIDs = append(userIDs, rule.UserIDs...)
+		teamNames = append(teamNames, rule.TeamNames...)
+	}
+	userIDs = dedupe(userIDs)
+	teamNames = dedupe(teamNames)
+
+	if len(userIDs) > 0 {
+		existing, err := s.repo.GetExistingUsers(ctx, userIDs)
+		if err != nil {
+			return err
+		}
+		if missing := missingKeys(userIDs, existing); len(missing) > 0 {
+			return fmt.Errorf("%w: users %v", ErrUnknownOwner, missing)
+		}
+	}
+
+	if len(teamNames) > 0 {
+		existing, err := s.repo.GetExistingTeams(ctx, teamNames)
+		if err != nil {
+			return err
+		}
+		if missing := missingKeys(teamNames, existing); len(missing) > 0 {
+			return fmt.Errorf("%w: teams %v", ErrUnknownOwner, missing)
+		}
+	}
+
+	return nil
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func missingKeys(keys, existing []string) []string {
+	found := make(map[string]bool, len(existing))
+	for _, key := range existing {
+		found[key] = true
+	}
+	var missing []string
+	for _, key := range keys {
+		if !found[key] {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
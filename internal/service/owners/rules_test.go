@@ -0,0 +1,291 @@
+package owners
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/owners"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockRepo struct {
+	mock.Mock
+}
+
+func (m *mockRepo) ListRules(ctx context.Context) ([]owners.OwnershipRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]owners.OwnershipRule), args.Error(1)
+}
+
+func (m *mockRepo) CreateRule(ctx context.Context, rule owners.OwnershipRule) (owners.OwnershipRule, error) {
+	args := m.Called(ctx, rule)
+	return args.Get(0).(owners.OwnershipRule), args.Error(1)
+}
+
+func (m *mockRepo) ReplaceRules(ctx context.Context, rules []owners.OwnershipRule) ([]owners.OwnershipRule, error) {
+	args := m.Called(ctx, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]owners.OwnershipRule), args.Error(1)
+}
+
+func (m *mockRepo) DeleteRule(ctx context.Context, ruleID int64) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockRepo) GetExistingUsers(ctx context.Context, userIDs []string) ([]string, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockRepo) GetExistingTeams(ctx context.Context, teamNames []string) ([]string, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func TestService_CreateRule(t *testing.T) {
+	tests := []struct {
+		name           string
+		rule           Rule
+		setupMock      func(*mockRepo)
+		expectedError  error
+		expectedResult Rule
+	}{
+		{
+			name: "successful create with duplicate owners",
+			rule: Rule{Pattern: "*.go", UserIDs: []string{"u1", "u1"}, TeamNames: []string{"backend"}},
+			setupMock: func(m *mockRepo) {
+				m.On("GetExistingUsers", mock.Anything, []string{"u1"}).Return([]string{"u1"}, nil)
+				m.On("GetExistingTeams", mock.Anything, []string{"backend"}).Return([]string{"backend"}, nil)
+				m.On("CreateRule", mock.Anything, owners.OwnershipRule{
+					Pattern:   "*.go",
+					UserIDs:   []string{"u1"},
+					TeamNames: []string{"backend"},
+				}).Return(owners.OwnershipRule{
+					RuleID:    1,
+					Pattern:   "*.go",
+					UserIDs:   []string{"u1"},
+					TeamNames: []string{"backend"},
+				}, nil)
+			},
+			expectedError:  nil,
+			expectedResult: Rule{RuleID: 1, Pattern: "*.go", UserIDs: []string{"u1"}, TeamNames: []string{"backend"}},
+		},
+		{
+			name: "negated pattern without owners",
+			rule: Rule{Pattern: "!docs/drafts/"},
+			setupMock: func(m *mockRepo) {
+				m.On("CreateRule", mock.Anything, owners.OwnershipRule{
+					Pattern:   "!docs/drafts/",
+					UserIDs:   []string{},
+					TeamNames: []string{},
+				}).Return(owners.OwnershipRule{
+					RuleID:    2,
+					Pattern:   "!docs/drafts/",
+					UserIDs:   []string{},
+					TeamNames: []string{},
+				}, nil)
+			},
+			expectedError:  nil,
+			expectedResult: Rule{RuleID: 2, Pattern: "!docs/drafts/", UserIDs: []string{}, TeamNames: []string{}},
+		},
+		{
+			name:           "invalid pattern",
+			rule:           Rule{Pattern: "/", UserIDs: []string{"u1"}},
+			setupMock:      func(m *mockRepo) {},
+			expectedError:  ErrInvalidRule,
+			expectedResult: Rule{},
+		},
+		{
+			name:           "negated pattern with owners",
+			rule:           Rule{Pattern: "!docs/", UserIDs: []string{"u1"}},
+			setupMock:      func(m *mockRepo) {},
+			expectedError:  ErrInvalidRule,
+			expectedResult: Rule{},
+		},
+		{
+			name: "unknown user",
+			rule: Rule{Pattern: "*.go", UserIDs: []string{"u1", "ghost"}},
+			setupMock: func(m *mockRepo) {
+				m.On("GetExistingUsers", mock.Anything, []string{"u1", "ghost"}).Return([]string{"u1"}, nil)
+			},
+			expectedError:  ErrUnknownOwner,
+			expectedResult: Rule{},
+		},
+		{
+			name: "unknown team",
+			rule: Rule{Pattern: "*.go", TeamNames: []string{"nope"}},
+			setupMock: func(m *mockRepo) {
+				m.On("GetExistingTeams", mock.Anything, []string{"nope"}).Return(nil, nil)
+			},
+			expectedError:  ErrUnknownOwner,
+			expectedResult: Rule{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.CreateRule(context.Background(), tt.rule)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResult, result)
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ImportRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		rules         []Rule
+		setupMock     func(*mockRepo)
+		expectedError error
+		expectedLen   int
+	}{
+		{
+			name: "successful import",
+			rules: []Rule{
+				{Pattern: "*", TeamNames: []string{"backend"}},
+				{Pattern: "/docs/", UserIDs: []string{"u1"}},
+				{Pattern: "/vendor/"},
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("GetExistingUsers", mock.Anything, []string{"u1"}).Return([]string{"u1"}, nil)
+				m.On("GetExistingTeams", mock.Anything, []string{"backend"}).Return([]string{"backend"}, nil)
+				m.On("ReplaceRules", mock.Anything, []owners.OwnershipRule{
+					{Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}},
+					{Pattern: "/docs/", UserIDs: []string{"u1"}, TeamNames: []string{}},
+					{Pattern: "/vendor/", UserIDs: []string{}, TeamNames: []string{}},
+				}).Return([]owners.OwnershipRule{
+					{RuleID: 1, Pattern: "*", TeamNames: []string{"backend"}},
+					{RuleID: 2, Pattern: "/docs/", UserIDs: []string{"u1"}},
+					{RuleID: 3, Pattern: "/vendor/"},
+				}, nil)
+			},
+			expectedError: nil,
+			expectedLen:   3,
+		},
+		{
+			name:  "empty import removes all rules",
+			rules: nil,
+			setupMock: func(m *mockRepo) {
+				m.On("ReplaceRules", mock.Anything, []owners.OwnershipRule{}).Return([]owners.OwnershipRule{}, nil)
+			},
+			expectedError: nil,
+			expectedLen:   0,
+		},
+		{
+			name: "unknown owner rejects whole import",
+			rules: []Rule{
+				{Pattern: "*", TeamNames: []string{"backend"}},
+				{Pattern: "/docs/", TeamNames: []string{"docs"}},
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("GetExistingTeams", mock.Anything, []string{"backend", "docs"}).Return([]string{"backend"}, nil)
+			},
+			expectedError: ErrUnknownOwner,
+			expectedLen:   0,
+		},
+		{
+			name:  "database error",
+			rules: []Rule{{Pattern: "*"}},
+			setupMock: func(m *mockRepo) {
+				m.On("ReplaceRules", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+			expectedLen:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.ImportRules(context.Background(), tt.rules)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, result, tt.expectedLen)
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeleteRule(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mockRepo)
+		expectedError error
+	}{
+		{
+			name: "successful delete",
+			setupMock: func(m *mockRepo) {
+				m.On("DeleteRule", mock.Anything, int64(5)).Return(true, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "rule not found",
+			setupMock: func(m *mockRepo) {
+				m.On("DeleteRule", mock.Anything, int64(5)).Return(false, nil)
+			},
+			expectedError: ErrRuleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			err := service.DeleteRule(context.Background(), 5)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package owners
+
+type Service struct {
+	repo Repo
+}
+
+func NewService(
+	repo Repo,
+) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
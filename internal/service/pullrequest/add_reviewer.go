@@ -0,0 +1,76 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrAlreadyAssigned  = apperr.New(apperr.KindConflict, "ALREADY_ASSIGNED", "reviewer is already assigned to this PR")
+	ErrTooManyReviewers = apperr.New(apperr.KindConflict, "TOO_MANY_REVIEWERS", "too many reviewers")
+)
+
+// AddReviewer вручную назначает ревьювера на открытый PR, если есть свободное место
+func (s *Service) AddReviewer(ctx context.Context, pullRequestID, userID string, expectedVersion int64) (PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.AddReviewer", attribute.String("pull_request_id", pullRequestID), attribute.String("user_id", userID))
+	defer span.End()
+
+	updatedPR, err := s.updatePullRequest(ctx, pullRequestID, expectedVersion, func(repoPR prrepo.PullRequest) (prrepo.PullRequest, error) {
+		if err := checkOpen(repoPR); err != nil {
+			return prrepo.PullRequest{}, err
+		}
+
+		if err := s.checkNewReviewer(ctx, repoPR, userID); err != nil {
+			return prrepo.PullRequest{}, err
+		}
+
+		if len(repoPR.AssignedReviewers) >= maxReviewers {
+			return prrepo.PullRequest{}, fmt.Errorf("%w: PR already has %d reviewers", ErrTooManyReviewers, maxReviewers)
+		}
+
+		newReviewers := append(append([]string{}, repoPR.AssignedReviewers...), userID)
+
+		return s.repo.UpdatePullRequestReviewers(ctx, pullRequestID, repoPR.Version, newReviewers)
+	})
+	if err != nil {
+		return PullRequest{}, err
+	}
+
+	pr := PullRequest{}
+	pr.FillFromDB(&updatedPR)
+
+	return pr, nil
+}
+
+// checkNewReviewer проверяет, что пользователя можно назначить на PR: он существует, активен,
+// не автор и еще не назначен
+func (s *Service) checkNewReviewer(ctx context.Context, repoPR prrepo.PullRequest, userID string) error {
+	if userID == repoPR.AuthorID {
+		return fmt.Errorf("%w: author %s cannot review own PR", ErrInvalidReviewers, userID)
+	}
+
+	if isReviewerAssigned(repoPR.AssignedReviewers, userID) {
+		return ErrAlreadyAssigned
+	}
+
+	reviewer, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: user %s", ErrNotFound, userID)
+		}
+		return err
+	}
+
+	if !reviewer.IsActive {
+		return fmt.Errorf("%w: reviewer %s is inactive", ErrInvalidReviewers, userID)
+	}
+
+	return nil
+}
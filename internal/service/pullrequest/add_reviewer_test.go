@@ -0,0 +1,155 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_AddReviewer(t *testing.T) {
+	openPR := prrepo.PullRequest{
+		PullRequestID:     "pr-001",
+		AuthorID:          "user-001",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"user-002"},
+	}
+
+	tests := []struct {
+		name          string
+		userID        string
+		setupMock     func(*mockRepo)
+		expectedError error
+		expectedPR    PullRequest
+	}{
+		{
+			name:   "successful add",
+			userID: "user-003",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+				m.On("GetUser", mock.Anything, "user-003").Return(user.User{UserID: "user-003", IsActive: true}, nil)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(0), []string{"user-002", "user-003"}).Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002", "user-003"},
+				}, nil)
+			},
+			expectedError: nil,
+			expectedPR: PullRequest{
+				PullRequestID:     "pr-001",
+				AuthorID:          "user-001",
+				Status:            "OPEN",
+				AssignedReviewers: []string{"user-002", "user-003"},
+			},
+		},
+		{
+			name:   "PR not found",
+			userID: "user-003",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{}, sql.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:   "PR merged",
+			userID: "user-003",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+					PullRequestID: "pr-001",
+					AuthorID:      "user-001",
+					Status:        "MERGED",
+				}, nil)
+			},
+			expectedError: ErrPRMerged,
+		},
+		{
+			name:   "author cannot be added",
+			userID: "user-001",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+			},
+			expectedError: ErrInvalidReviewers,
+		},
+		{
+			name:   "already assigned",
+			userID: "user-002",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+			},
+			expectedError: ErrAlreadyAssigned,
+		},
+		{
+			name:   "user not found",
+			userID: "ghost",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+				m.On("GetUser", mock.Anything, "ghost").Return(user.User{}, sql.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:   "inactive user",
+			userID: "user-003",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+				m.On("GetUser", mock.Anything, "user-003").Return(user.User{UserID: "user-003", IsActive: false}, nil)
+			},
+			expectedError: ErrInvalidReviewers,
+		},
+		{
+			name:   "no free slot",
+			userID: "user-004",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002", "user-003"},
+				}, nil)
+				m.On("GetUser", mock.Anything, "user-004").Return(user.User{UserID: "user-004", IsActive: true}, nil)
+			},
+			expectedError: ErrTooManyReviewers,
+		},
+		{
+			name:   "error updating reviewers",
+			userID: "user-003",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+				m.On("GetUser", mock.Anything, "user-003").Return(user.User{UserID: "user-003", IsActive: true}, nil)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(0), []string{"user-002", "user-003"}).
+					Return(prrepo.PullRequest{}, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.AddReviewer(context.Background(), "pr-001", tt.userID, 0)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Equal(t, PullRequest{}, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPR, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -3,10 +3,16 @@ package pullrequest
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
 	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type BulkDeactivateResult struct {
@@ -21,6 +27,9 @@ type ReassignedPR struct {
 }
 
 func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string) (BulkDeactivateResult, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.BulkDeactivateTeamUsers", attribute.String("team_name", teamName))
+	defer span.End()
+
 	deactivatedUserIDs, err := s.repo.BulkDeactivateTeamUsers(ctx, teamName)
 	if err != nil {
 		return BulkDeactivateResult{}, err
@@ -33,28 +42,58 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string)
 		}, nil
 	}
 
-	openPRs, err := s.repo.GetOpenPRsByReviewers(ctx, deactivatedUserIDs)
-	if err != nil {
-		return BulkDeactivateResult{}, err
-	}
+	log := logging.FromContext(ctx).WithField("team_name", teamName)
+
+	// PR могут параллельно меняться через API: при конфликте версий переназначение
+	// пересчитывается на свежих данных
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		reassignedPRs, err := s.reassignDeactivated(ctx, deactivatedUserIDs)
+		if errors.Is(err, store.ErrConflict) {
+			log.WithField("attempt", attempt+1).Warn("Open PRs were modified concurrently, retrying reassignment")
+			span.AddEvent("reassignment conflict, retrying")
+			continue
+		}
+		if err != nil {
+			return BulkDeactivateResult{}, err
+		}
+
+		log.WithFields(logrus.Fields{
+			"deactivated_users": len(deactivatedUserIDs),
+			"reassigned_prs":    len(reassignedPRs),
+		}).Info("Team users deactivated")
+		span.SetAttributes(
+			attribute.Int("deactivated_users", len(deactivatedUserIDs)),
+			attribute.Int("reassigned_prs", len(reassignedPRs)),
+		)
 
-	if len(openPRs) == 0 {
 		return BulkDeactivateResult{
 			DeactivatedUserIDs: deactivatedUserIDs,
-			ReassignedPRs:      []ReassignedPR{},
+			ReassignedPRs:      reassignedPRs,
 		}, nil
 	}
 
-	teamMembers, err := s.repo.GetActiveTeamMembers(ctx, teamName, "")
+	return BulkDeactivateResult{}, fmt.Errorf("%w: open PRs of team %s were modified concurrently", ErrConcurrentUpdate, teamName)
+}
+
+// reassignDeactivated заменяет деактивированных ревьюверов в открытых PR одним пакетным обновлением.
+// Если какой-то PR изменился после чтения, возвращает store.ErrConflict
+func (s *Service) reassignDeactivated(ctx context.Context, deactivatedUserIDs []string) ([]ReassignedPR, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.reassignDeactivated")
+	defer span.End()
+
+	openPRs, err := s.repo.GetOpenPRsByReviewers(ctx, deactivatedUserIDs)
 	if err != nil {
-		return BulkDeactivateResult{}, err
+		return nil, err
 	}
 
-	activeUserIDs := make(map[string]bool)
-	for _, member := range teamMembers {
-		activeUserIDs[member.UserID] = true
+	if len(openPRs) == 0 {
+		return []ReassignedPR{}, nil
 	}
 
+	// участники могут состоять и в других командах: их ревью переназначаются
+	// внутри команды каждого PR, активные участники загружаются один раз на команду
+	activeByTeam := make(map[string]map[string]bool)
+
 	deactivatedSet := make(map[string]bool)
 	for _, userID := range deactivatedUserIDs {
 		deactivatedSet[userID] = true
@@ -64,10 +103,23 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string)
 	var prUpdates []prrepo.PRReviewerUpdate
 
 	for _, pr := range openPRs {
-		if pr.AuthorTeamName != teamName {
+		if pr.TeamName == "" {
 			continue
 		}
 
+		activeUserIDs, ok := activeByTeam[pr.TeamName]
+		if !ok {
+			teamMembers, err := s.repo.GetActiveTeamMembers(ctx, pr.TeamName, "")
+			if err != nil {
+				return nil, err
+			}
+			activeUserIDs = make(map[string]bool, len(teamMembers))
+			for _, member := range teamMembers {
+				activeUserIDs[member.UserID] = true
+			}
+			activeByTeam[pr.TeamName] = activeUserIDs
+		}
+
 		needsReassignment := false
 		newReviewers := make([]string, 0, len(pr.AssignedReviewers))
 		replacedReviewers := make(map[string]string)
@@ -75,12 +127,12 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string)
 		for _, reviewerID := range pr.AssignedReviewers {
 			if deactivatedSet[reviewerID] {
 				needsReassignment = true
-				candidate, err := s.findReplacementForDeactivated(ctx, teamName, reviewerID, pr.AuthorID, pr.AssignedReviewers, activeUserIDs, replacedReviewers)
+				candidate, err := s.findReplacementForDeactivated(ctx, pr.TeamName, reviewerID, pr.AuthorID, pr.AssignedReviewers, activeUserIDs, replacedReviewers)
 				if err != nil {
 					if errors.Is(err, ErrNoCandidate) {
 						continue
 					}
-					return BulkDeactivateResult{}, err
+					return nil, err
 				}
 				newReviewers = append(newReviewers, candidate)
 				replacedReviewers[reviewerID] = candidate
@@ -98,6 +150,7 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string)
 			prUpdates = append(prUpdates, prrepo.PRReviewerUpdate{
 				PullRequestID:     pr.PullRequestID,
 				AssignedReviewers: newReviewers,
+				Version:           pr.Version,
 			})
 		}
 	}
@@ -105,14 +158,11 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string)
 	if len(prUpdates) > 0 {
 		err := s.repo.BulkUpdatePullRequestReviewers(ctx, prUpdates)
 		if err != nil {
-			return BulkDeactivateResult{}, err
+			return nil, err
 		}
 	}
 
-	return BulkDeactivateResult{
-		DeactivatedUserIDs: deactivatedUserIDs,
-		ReassignedPRs:      reassignedPRs,
-	}, nil
+	return reassignedPRs, nil
 }
 
 func (s *Service) findReplacementForDeactivated(ctx context.Context, teamName, oldUserID, authorID string, currentReviewers []string, activeUserIDs map[string]bool, alreadyReplaced map[string]string) (string, error) {
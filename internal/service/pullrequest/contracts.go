@@ -5,19 +5,32 @@ import (
 
 	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/service/owners"
 )
 
 type Repo interface {
 	PRExists(ctx context.Context, pullRequestID string) (bool, error)
+	TeamExists(ctx context.Context, teamName string) (bool, error)
 	GetUser(ctx context.Context, userID string) (user.User, error)
 	GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]user.User, error)
+	GetUsersActivity(ctx context.Context, userIDs []string) (map[string]bool, error)
+	GetActiveOwners(ctx context.Context, userIDs, teamNames []string, excludeUserID string) ([]string, error)
+	GetUsersWithExpertise(ctx context.Context, userIDs, tags []string) ([]string, error)
 	CreatePullRequest(ctx context.Context, request *prrepo.CreatePullRequest) (prrepo.PullRequest, error)
 	GetPullRequest(ctx context.Context, pullRequestID string) (prrepo.PullRequest, error)
-	MergePullRequest(ctx context.Context, pullRequestID string) (prrepo.PullRequest, error)
-	UpdatePullRequestReviewers(ctx context.Context, pullRequestID string, assignedReviewers []string) (prrepo.PullRequest, error)
+	ListPullRequests(ctx context.Context, filter prrepo.PullRequestListFilter) ([]prrepo.PullRequest, error)
+	GetUsernames(ctx context.Context, userIDs []string) (map[string]string, error)
+	MergePullRequest(ctx context.Context, pullRequestID string, version int64) (prrepo.PullRequest, error)
+	UpdatePullRequestReviewers(ctx context.Context, pullRequestID string, version int64, assignedReviewers []string) (prrepo.PullRequest, error)
 	GetReviewerStats(ctx context.Context) ([]prrepo.ReviewerStats, error)
 	GetPRStats(ctx context.Context) (prrepo.PRStats, error)
 	GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) ([]prrepo.OpenPRWithReviewer, error)
 	BulkDeactivateTeamUsers(ctx context.Context, teamName string) ([]string, error)
 	BulkUpdatePullRequestReviewers(ctx context.Context, updates []prrepo.PRReviewerUpdate) error
 }
+
+// OwnersResolver определяет владельцев путей по правилам CODEOWNERS; реализуется owners.Service,
+// поэтому подбор ревьюверов и /owners/resolve сопоставляют файлы одинаково
+type OwnersResolver interface {
+	ResolveOwners(ctx context.Context, paths []string) (owners.Resolution, error)
+}
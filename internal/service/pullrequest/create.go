@@ -4,19 +4,32 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
-	ErrPRExists = errors.New("PR_EXISTS")
-	ErrNotFound = errors.New("NOT_FOUND")
+	ErrPRExists         = apperr.New(apperr.KindConflict, "PR_EXISTS", "PR id already exists")
+	ErrNotFound         = apperr.New(apperr.KindNotFound, "NOT_FOUND", "not found")
+	ErrTeamRequired     = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "author belongs to several teams, team_name is required")
+	ErrInvalidReviewers = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid reviewers")
+	ErrReviewerNotFound = apperr.New(apperr.KindNotFound, "NOT_FOUND", "reviewers not found")
 )
 
 func (s *Service) CreatePullRequest(ctx context.Context, req CreatePullRequest) (PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.CreatePullRequest", attribute.String("pull_request_id", req.PullRequestId), attribute.String("author_id", req.AuthorId))
+	defer span.End()
+
 	exists, err := s.repo.PRExists(ctx, req.PullRequestId)
 	if err != nil {
 		return PullRequest{}, err
@@ -28,33 +41,83 @@ func (s *Service) CreatePullRequest(ctx context.Context, req CreatePullRequest)
 	author, err := s.repo.GetUser(ctx, req.AuthorId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return PullRequest{}, ErrNotFound
+			return PullRequest{}, fmt.Errorf("%w: author %s", ErrNotFound, req.AuthorId)
 		}
 		return PullRequest{}, err
 	}
 
-	teamMembers, err := s.repo.GetActiveTeamMembers(ctx, author.TeamName, req.AuthorId)
+	teamName, err := s.resolvePRTeam(ctx, req.TeamName, author)
 	if err != nil {
 		return PullRequest{}, err
 	}
 
-	assignedReviewers := selectRandomReviewers(teamMembers, 2)
+	req.Files = normalizeFiles(req.Files)
+	req.Labels = normalizeLabels(req.Labels)
+	req.RequestedReviewers = dedupe(req.RequestedReviewers)
+	req.ExcludedReviewers = dedupe(req.ExcludedReviewers)
+
+	if err := s.validateReviewerRequests(ctx, req); err != nil {
+		return PullRequest{}, err
+	}
+
+	assignedReviewers, err := s.selectReviewers(ctx, teamName, req)
+	if err != nil {
+		return PullRequest{}, err
+	}
 
 	reqToDB := req.ToDB()
+	reqToDB.TeamName = teamName
 	reqToDB.Status = models.PullRequestStatusOPEN
 	reqToDB.AssignedReviewers = assignedReviewers
 
 	repoPR, err := s.repo.CreatePullRequest(ctx, reqToDB)
 	if err != nil {
+		// PR с тем же ID или удаление автора/команды между проверкой и вставкой
+		switch {
+		case errors.Is(err, store.ErrDuplicateKey):
+			return PullRequest{}, ErrPRExists
+		case errors.Is(err, store.ErrForeignKeyViolated):
+			return PullRequest{}, fmt.Errorf("%w: author %s or team %s", ErrNotFound, req.AuthorId, teamName)
+		}
 		return PullRequest{}, err
 	}
 
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"pull_request_id": repoPR.PullRequestID,
+		"team_name":       teamName,
+		"reviewers":       assignedReviewers,
+	}).Info("Pull request created")
+
 	pr := PullRequest{}
 	pr.FillFromDB(&repoPR)
 
 	return pr, nil
 }
 
+// resolvePRTeam определяет команду, в которую подается PR: явно указанную или единственную команду автора.
+// Автор без команд получает PR без команды и без ревьюверов
+func (s *Service) resolvePRTeam(ctx context.Context, teamName string, author user.User) (string, error) {
+	if teamName != "" {
+		exists, err := s.repo.TeamExists(ctx, teamName)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return "", fmt.Errorf("%w: team %s", ErrNotFound, teamName)
+		}
+		return teamName, nil
+	}
+
+	switch len(author.TeamNames) {
+	case 0:
+		return "", nil
+	case 1:
+		return author.TeamNames[0], nil
+	default:
+		return "", ErrTeamRequired
+	}
+}
+
 func selectRandomReviewers(members []user.User, maxReviewers int) []string {
 	if len(members) == 0 {
 		return []string{}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 
 	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -21,6 +24,11 @@ func (m *mockRepo) PRExists(ctx context.Context, pullRequestID string) (bool, er
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *mockRepo) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	args := m.Called(ctx, teamName)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *mockRepo) GetUser(ctx context.Context, userID string) (user.User, error) {
 	args := m.Called(ctx, userID)
 	if args.Get(0) == nil {
@@ -37,6 +45,39 @@ func (m *mockRepo) GetActiveTeamMembers(ctx context.Context, teamName string, ex
 	return args.Get(0).([]user.User), args.Error(1)
 }
 
+func (m *mockRepo) GetUsersActivity(ctx context.Context, userIDs []string) (map[string]bool, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+type mockOwners struct {
+	mock.Mock
+}
+
+func (m *mockOwners) ResolveOwners(ctx context.Context, paths []string) (owners.Resolution, error) {
+	args := m.Called(ctx, paths)
+	return args.Get(0).(owners.Resolution), args.Error(1)
+}
+
+func (m *mockRepo) GetActiveOwners(ctx context.Context, userIDs, teamNames []string, excludeUserID string) ([]string, error) {
+	args := m.Called(ctx, userIDs, teamNames, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockRepo) GetUsersWithExpertise(ctx context.Context, userIDs, tags []string) ([]string, error) {
+	args := m.Called(ctx, userIDs, tags)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (m *mockRepo) CreatePullRequest(ctx context.Context, request *prrepo.CreatePullRequest) (prrepo.PullRequest, error) {
 	args := m.Called(ctx, request)
 	if args.Get(0) == nil {
@@ -53,16 +94,32 @@ func (m *mockRepo) GetPullRequest(ctx context.Context, pullRequestID string) (pr
 	return args.Get(0).(prrepo.PullRequest), args.Error(1)
 }
 
-func (m *mockRepo) MergePullRequest(ctx context.Context, pullRequestID string) (prrepo.PullRequest, error) {
-	args := m.Called(ctx, pullRequestID)
+func (m *mockRepo) ListPullRequests(ctx context.Context, filter prrepo.PullRequestListFilter) ([]prrepo.PullRequest, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]prrepo.PullRequest), args.Error(1)
+}
+
+func (m *mockRepo) GetUsernames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+func (m *mockRepo) MergePullRequest(ctx context.Context, pullRequestID string, version int64) (prrepo.PullRequest, error) {
+	args := m.Called(ctx, pullRequestID, version)
 	if args.Get(0) == nil {
 		return prrepo.PullRequest{}, args.Error(1)
 	}
 	return args.Get(0).(prrepo.PullRequest), args.Error(1)
 }
 
-func (m *mockRepo) UpdatePullRequestReviewers(ctx context.Context, pullRequestID string, assignedReviewers []string) (prrepo.PullRequest, error) {
-	args := m.Called(ctx, pullRequestID, assignedReviewers)
+func (m *mockRepo) UpdatePullRequestReviewers(ctx context.Context, pullRequestID string, version int64, assignedReviewers []string) (prrepo.PullRequest, error) {
+	args := m.Called(ctx, pullRequestID, version, assignedReviewers)
 	if args.Get(0) == nil {
 		return prrepo.PullRequest{}, args.Error(1)
 	}
@@ -124,10 +181,11 @@ func TestService_CreatePullRequest(t *testing.T) {
 			setupMock: func(m *mockRepo) {
 				m.On("PRExists", mock.Anything, "pr-001").Return(false, nil)
 				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
 				}, nil)
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-001").Return([]user.User{
 					{UserID: "user-002", Username: "bob", TeamName: "backend", IsActive: true},
@@ -164,10 +222,11 @@ func TestService_CreatePullRequest(t *testing.T) {
 			setupMock: func(m *mockRepo) {
 				m.On("PRExists", mock.Anything, "pr-002").Return(false, nil)
 				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
 				}, nil)
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-001").Return([]user.User{
 					{UserID: "user-002", Username: "bob", TeamName: "backend", IsActive: true},
@@ -198,10 +257,11 @@ func TestService_CreatePullRequest(t *testing.T) {
 			setupMock: func(m *mockRepo) {
 				m.On("PRExists", mock.Anything, "pr-003").Return(false, nil)
 				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
 				}, nil)
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-001").Return([]user.User{}, nil)
 				m.On("CreatePullRequest", mock.Anything, mock.AnythingOfType("*pullrequest.CreatePullRequest")).Return(
@@ -219,6 +279,180 @@ func TestService_CreatePullRequest(t *testing.T) {
 				assert.Empty(t, pr.AssignedReviewers)
 			},
 		},
+		{
+			name: "explicit team for author in several teams",
+			request: CreatePullRequest{
+				PullRequestId:   "pr-010",
+				PullRequestName: "Test PR 10",
+				AuthorId:        "user-001",
+				TeamName:        "devops",
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-010").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend", "devops"},
+					IsActive:  true,
+				}, nil)
+				m.On("TeamExists", mock.Anything, "devops").Return(true, nil)
+				m.On("GetActiveTeamMembers", mock.Anything, "devops", "user-001").Return([]user.User{
+					{UserID: "user-005", Username: "eve", TeamName: "devops", IsActive: true},
+				}, nil)
+				m.On("CreatePullRequest", mock.Anything, mock.MatchedBy(func(req *prrepo.CreatePullRequest) bool {
+					return req.TeamName == "devops" && len(req.AssignedReviewers) == 1 && req.AssignedReviewers[0] == "user-005"
+				})).Return(
+					prrepo.PullRequest{
+						PullRequestID:     "pr-010",
+						PullRequestName:   "Test PR 10",
+						AuthorID:          "user-001",
+						TeamName:          "devops",
+						Status:            "OPEN",
+						AssignedReviewers: []string{"user-005"},
+					}, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, pr PullRequest) {
+				assert.Equal(t, "devops", pr.TeamName)
+				assert.Equal(t, []string{"user-005"}, pr.AssignedReviewers)
+			},
+		},
+		{
+			name: "team required for author in several teams",
+			request: CreatePullRequest{
+				PullRequestId:   "pr-011",
+				PullRequestName: "Test PR 11",
+				AuthorId:        "user-001",
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-011").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend", "devops"},
+					IsActive:  true,
+				}, nil)
+			},
+			expectedError:  ErrTeamRequired,
+			validateResult: nil,
+		},
+		{
+			name: "explicit team not found",
+			request: CreatePullRequest{
+				PullRequestId:   "pr-012",
+				PullRequestName: "Test PR 12",
+				AuthorId:        "user-001",
+				TeamName:        "nonexistent",
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-012").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
+				}, nil)
+				m.On("TeamExists", mock.Anything, "nonexistent").Return(false, nil)
+			},
+			expectedError:  ErrNotFound,
+			validateResult: nil,
+		},
+		{
+			name: "author without team gets no reviewers",
+			request: CreatePullRequest{
+				PullRequestId:   "pr-013",
+				PullRequestName: "Test PR 13",
+				AuthorId:        "user-001",
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-013").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:   "user-001",
+					Username: "alice",
+					IsActive: true,
+				}, nil)
+				m.On("CreatePullRequest", mock.Anything, mock.MatchedBy(func(req *prrepo.CreatePullRequest) bool {
+					return req.TeamName == "" && len(req.AssignedReviewers) == 0
+				})).Return(
+					prrepo.PullRequest{
+						PullRequestID:     "pr-013",
+						PullRequestName:   "Test PR 13",
+						AuthorID:          "user-001",
+						Status:            "OPEN",
+						AssignedReviewers: []string{},
+					}, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, pr PullRequest) {
+				assert.Empty(t, pr.AssignedReviewers)
+			},
+		},
+		{
+			name: "requested reviewer is assigned, excluded is not",
+			request: CreatePullRequest{
+				PullRequestId:      "pr-014",
+				PullRequestName:    "Test PR 14",
+				AuthorId:           "user-001",
+				RequestedReviewers: []string{"user-009"},
+				ExcludedReviewers:  []string{"user-002"},
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-014").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
+				}, nil)
+				m.On("GetUsersActivity", mock.Anything, []string{"user-009", "user-002"}).
+					Return(map[string]bool{"user-009": true, "user-002": true}, nil)
+				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-001").Return([]user.User{
+					{UserID: "user-002", Username: "bob", TeamName: "backend", IsActive: true},
+					{UserID: "user-003", Username: "charlie", TeamName: "backend", IsActive: true},
+				}, nil)
+				m.On("CreatePullRequest", mock.Anything, mock.MatchedBy(func(req *prrepo.CreatePullRequest) bool {
+					return len(req.AssignedReviewers) == 2 && req.AssignedReviewers[0] == "user-009" && req.AssignedReviewers[1] == "user-003"
+				})).Return(
+					prrepo.PullRequest{
+						PullRequestID:     "pr-014",
+						PullRequestName:   "Test PR 14",
+						AuthorID:          "user-001",
+						TeamName:          "backend",
+						Status:            "OPEN",
+						AssignedReviewers: []string{"user-009", "user-003"},
+					}, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, pr PullRequest) {
+				assert.Equal(t, []string{"user-009", "user-003"}, pr.AssignedReviewers)
+			},
+		},
+		{
+			name: "requested reviewer not found",
+			request: CreatePullRequest{
+				PullRequestId:      "pr-015",
+				PullRequestName:    "Test PR 15",
+				AuthorId:           "user-001",
+				RequestedReviewers: []string{"ghost"},
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-015").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
+				}, nil)
+				m.On("GetUsersActivity", mock.Anything, []string{"ghost"}).Return(map[string]bool{}, nil)
+			},
+			expectedError:  ErrReviewerNotFound,
+			validateResult: nil,
+		},
 		{
 			name: "PR already exists",
 			request: CreatePullRequest{
@@ -283,10 +517,11 @@ func TestService_CreatePullRequest(t *testing.T) {
 			setupMock: func(m *mockRepo) {
 				m.On("PRExists", mock.Anything, "pr-008").Return(false, nil)
 				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
 				}, nil)
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-001").Return(nil, errors.New("database error"))
 			},
@@ -303,10 +538,11 @@ func TestService_CreatePullRequest(t *testing.T) {
 			setupMock: func(m *mockRepo) {
 				m.On("PRExists", mock.Anything, "pr-009").Return(false, nil)
 				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
 				}, nil)
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-001").Return([]user.User{
 					{UserID: "user-002", Username: "bob", TeamName: "backend", IsActive: true},
@@ -316,6 +552,22 @@ func TestService_CreatePullRequest(t *testing.T) {
 			expectedError: errors.New("database error"),
 			validateResult: nil,
 		},
+		{
+			name: "PR created concurrently",
+			request: CreatePullRequest{
+				PullRequestId:   "pr-011",
+				PullRequestName: "Test PR 11",
+				AuthorId:        "user-001",
+			},
+			setupMock: func(m *mockRepo) {
+				m.On("PRExists", mock.Anything, "pr-011").Return(false, nil)
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{UserID: "user-001", Username: "alice"}, nil)
+				m.On("CreatePullRequest", mock.Anything, mock.AnythingOfType("*pullrequest.CreatePullRequest")).
+					Return(nil, fmt.Errorf("%w: pullrequests_pkey", store.ErrDuplicateKey))
+			},
+			expectedError:  ErrPRExists,
+			validateResult: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -336,6 +588,10 @@ func TestService_CreatePullRequest(t *testing.T) {
 					assert.ErrorIs(t, err, ErrPRExists)
 				} else if errors.Is(tt.expectedError, ErrNotFound) {
 					assert.ErrorIs(t, err, ErrNotFound)
+				} else if errors.Is(tt.expectedError, ErrTeamRequired) {
+					assert.ErrorIs(t, err, ErrTeamRequired)
+				} else if errors.Is(tt.expectedError, ErrReviewerNotFound) {
+					assert.ErrorIs(t, err, ErrReviewerNotFound)
 				} else {
 					assert.Contains(t, err.Error(), tt.expectedError.Error())
 				}
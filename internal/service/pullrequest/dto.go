@@ -10,26 +10,42 @@ type PullRequest struct {
 	PullRequestID     string
 	PullRequestName   string
 	AuthorID          string
+	TeamName          string
 	Status            string
 	AssignedReviewers []string
+	Files             []string
+	Labels            []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	Version           int64
 }
 
+// CreatePullRequest запрос на создание PR; TeamName можно не указывать, если автор состоит в одной команде.
+// Files и Labels используются для подбора ревьюверов по правилам владения и экспертизе.
+// RequestedReviewers назначаются обязательно, ExcludedReviewers не назначаются никогда
 type CreatePullRequest struct {
-	AuthorId        string
-	PullRequestId   string
-	PullRequestName string
+	AuthorId           string
+	PullRequestId      string
+	PullRequestName    string
+	TeamName           string
+	Files              []string
+	Labels             []string
+	RequestedReviewers []string
+	ExcludedReviewers  []string
 }
 
 func (m *PullRequest) FillFromDB(dbp *prrepo.PullRequest) {
 	m.PullRequestID = dbp.PullRequestID
 	m.PullRequestName = dbp.PullRequestName
 	m.AuthorID = dbp.AuthorID
+	m.TeamName = dbp.TeamName
 	m.Status = dbp.Status
 	m.AssignedReviewers = dbp.AssignedReviewers
+	m.Files = dbp.Files
+	m.Labels = dbp.Labels
 	m.CreatedAt = dbp.CreatedAt
 	m.MergedAt = dbp.MergedAt
+	m.Version = dbp.Version
 }
 
 func (m *CreatePullRequest) ToDB() *prrepo.CreatePullRequest {
@@ -37,5 +53,44 @@ func (m *CreatePullRequest) ToDB() *prrepo.CreatePullRequest {
 		AuthorId:        m.AuthorId,
 		PullRequestId:   m.PullRequestId,
 		PullRequestName: m.PullRequestName,
+		TeamName:        m.TeamName,
+		Files:           m.Files,
+		Labels:          m.Labels,
 	}
 }
+
+// Reviewer ревьювер PR с именем пользователя
+type Reviewer struct {
+	UserID   string
+	Username string
+}
+
+// PullRequestDetails PR с именами автора и ревьюверов
+type PullRequestDetails struct {
+	PullRequest
+	AuthorUsername string
+	Reviewers      []Reviewer
+}
+
+// ListPullRequestsParams фильтры, сортировка и страница списка PR; пустые поля не фильтруют.
+// CreatedFrom включительно, CreatedTo исключительно. Sort - SortCreatedAt (по умолчанию) или SortID,
+// Order - OrderDesc (по умолчанию) или OrderAsc. Cursor - NextCursor предыдущей страницы
+type ListPullRequestsParams struct {
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	Status      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Sort        string
+	Order       string
+	Limit       int
+	Cursor      string
+}
+
+// PullRequestPage страница списка PR; NextCursor пуст на последней странице
+type PullRequestPage struct {
+	PullRequests []PullRequestDetails
+	NextCursor   string
+	Limit        int
+}
@@ -0,0 +1,82 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetPullRequest возвращает PR по ID
+func (s *Service) GetPullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.GetPullRequest", attribute.String("pull_request_id", pullRequestID))
+	defer span.End()
+
+	repoPR, err := s.repo.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PullRequest{}, fmt.Errorf("%w: PR %s", ErrNotFound, pullRequestID)
+		}
+		return PullRequest{}, err
+	}
+
+	pr := PullRequest{}
+	pr.FillFromDB(&repoPR)
+
+	return pr, nil
+}
+
+// GetPullRequestDetails возвращает PR по ID с именами автора и ревьюверов
+func (s *Service) GetPullRequestDetails(ctx context.Context, pullRequestID string) (PullRequestDetails, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.GetPullRequestDetails", attribute.String("pull_request_id", pullRequestID))
+	defer span.End()
+
+	repoPR, err := s.repo.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PullRequestDetails{}, fmt.Errorf("%w: PR %s", ErrNotFound, pullRequestID)
+		}
+		return PullRequestDetails{}, err
+	}
+
+	prs, err := s.withUsernames(ctx, []prrepo.PullRequest{repoPR})
+	if err != nil {
+		return PullRequestDetails{}, err
+	}
+
+	return prs[0], nil
+}
+
+// withUsernames дополняет PR именами автора и ревьюверов одним запросом к пользователям
+func (s *Service) withUsernames(ctx context.Context, repoPRs []prrepo.PullRequest) ([]PullRequestDetails, error) {
+	result := make([]PullRequestDetails, len(repoPRs))
+	if len(repoPRs) == 0 {
+		return result, nil
+	}
+
+	var userIDs []string
+	for _, pr := range repoPRs {
+		userIDs = append(userIDs, pr.AuthorID)
+		userIDs = append(userIDs, pr.AssignedReviewers...)
+	}
+
+	usernames, err := s.repo.GetUsernames(ctx, dedupe(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range repoPRs {
+		result[i].FillFromDB(&repoPRs[i])
+		result[i].AuthorUsername = usernames[repoPRs[i].AuthorID]
+		result[i].Reviewers = make([]Reviewer, len(repoPRs[i].AssignedReviewers))
+		for j, userID := range repoPRs[i].AssignedReviewers {
+			result[i].Reviewers[j] = Reviewer{UserID: userID, Username: usernames[userID]}
+		}
+	}
+
+	return result, nil
+}
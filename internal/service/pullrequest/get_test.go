@@ -0,0 +1,116 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetPullRequest(t *testing.T) {
+	createdAt := time.Now()
+
+	tests := []struct {
+		name          string
+		pullRequestID string
+		setupMock     func(*mockRepo)
+		expectedError error
+		expectedPR    PullRequest
+	}{
+		{
+			name:          "successful get",
+			pullRequestID: "pr-001",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					PullRequestName:   "Test PR",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002"},
+					CreatedAt:         &createdAt,
+				}, nil)
+			},
+			expectedPR: PullRequest{
+				PullRequestID:     "pr-001",
+				PullRequestName:   "Test PR",
+				AuthorID:          "user-001",
+				Status:            "OPEN",
+				AssignedReviewers: []string{"user-002"},
+				CreatedAt:         &createdAt,
+			},
+		},
+		{
+			name:          "PR not found",
+			pullRequestID: "pr-999",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-999").Return(prrepo.PullRequest{}, sql.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:          "database error",
+			pullRequestID: "pr-001",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{}, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.GetPullRequest(context.Background(), tt.pullRequestID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrNotFound) {
+					assert.ErrorIs(t, err, ErrNotFound)
+				} else {
+					assert.Contains(t, err.Error(), tt.expectedError.Error())
+				}
+				assert.Equal(t, PullRequest{}, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPR, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetPullRequestDetails(t *testing.T) {
+	mockRepo := new(mockRepo)
+	mockRepo.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+		PullRequestID:     "pr-001",
+		AuthorID:          "u1",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"u2", "u3"},
+	}, nil)
+	mockRepo.On("GetUsernames", mock.Anything, []string{"u1", "u2", "u3"}).
+		Return(map[string]string{"u1": "Alice", "u2": "Bob"}, nil)
+
+	service := &Service{
+		repo: mockRepo,
+	}
+
+	result, err := service.GetPullRequestDetails(context.Background(), "pr-001")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "pr-001", result.PullRequestID)
+	assert.Equal(t, "Alice", result.AuthorUsername)
+	assert.Equal(t, []Reviewer{{UserID: "u2", Username: "Bob"}, {UserID: "u3"}}, result.Reviewers)
+	mockRepo.AssertExpectations(t)
+}
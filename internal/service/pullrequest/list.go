@@ -0,0 +1,164 @@
+package pullrequest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 100
+
+	SortCreatedAt = prrepo.SortByCreatedAt
+	SortID        = prrepo.SortByID
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+var (
+	ErrInvalidPagination = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid pagination")
+	ErrInvalidFilter     = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid filter")
+	ErrInvalidCursor     = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid cursor")
+)
+
+// listCursor ключ последнего PR страницы; сортировка и порядок сохраняются,
+// чтобы курсор нельзя было применить к другому списку
+type listCursor struct {
+	Sort      string    `json:"s"`
+	Order     string    `json:"o"`
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// ListPullRequests возвращает страницу PR под фильтром с курсорной пагинацией
+func (s *Service) ListPullRequests(ctx context.Context, params ListPullRequestsParams) (PullRequestPage, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.ListPullRequests")
+	defer span.End()
+
+	if params.Limit == 0 {
+		params.Limit = DefaultListLimit
+	}
+	if params.Limit < 0 || params.Limit > MaxListLimit {
+		return PullRequestPage{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidPagination, MaxListLimit)
+	}
+
+	filter, err := listFilter(params)
+	if err != nil {
+		return PullRequestPage{}, err
+	}
+
+	// запрашиваем на один PR больше, чтобы понять, есть ли следующая страница
+	filter.Limit = params.Limit + 1
+	repoPRs, err := s.repo.ListPullRequests(ctx, filter)
+	if err != nil {
+		return PullRequestPage{}, err
+	}
+
+	var nextCursor string
+	if len(repoPRs) > params.Limit {
+		repoPRs = repoPRs[:params.Limit]
+		last := repoPRs[len(repoPRs)-1]
+		cursor := listCursor{Sort: filter.SortBy, Order: orderName(filter.Desc), ID: last.PullRequestID}
+		if last.CreatedAt != nil {
+			cursor.CreatedAt = *last.CreatedAt
+		}
+		nextCursor = encodeCursor(cursor)
+	}
+
+	prs, err := s.withUsernames(ctx, repoPRs)
+	if err != nil {
+		return PullRequestPage{}, err
+	}
+
+	return PullRequestPage{
+		PullRequests: prs,
+		NextCursor:   nextCursor,
+		Limit:        params.Limit,
+	}, nil
+}
+
+// listFilter проверяет параметры списка и переводит их в фильтр репозитория
+func listFilter(params ListPullRequestsParams) (prrepo.PullRequestListFilter, error) {
+	filter := prrepo.PullRequestListFilter{
+		AuthorID:   params.AuthorID,
+		ReviewerID: params.ReviewerID,
+		TeamName:   params.TeamName,
+		Status:     params.Status,
+	}
+
+	switch params.Status {
+	case "", string(models.PullRequestStatusOPEN), string(models.PullRequestStatusMERGED):
+	default:
+		return filter, fmt.Errorf("%w: status must be OPEN or MERGED", ErrInvalidFilter)
+	}
+
+	switch params.Sort {
+	case "", SortCreatedAt:
+		filter.SortBy = SortCreatedAt
+	case SortID:
+		filter.SortBy = SortID
+	default:
+		return filter, fmt.Errorf("%w: sort must be %s or %s", ErrInvalidFilter, SortCreatedAt, SortID)
+	}
+
+	switch params.Order {
+	case "", OrderDesc:
+		filter.Desc = true
+	case OrderAsc:
+	default:
+		return filter, fmt.Errorf("%w: order must be %s or %s", ErrInvalidFilter, OrderAsc, OrderDesc)
+	}
+
+	if params.CreatedFrom != nil && params.CreatedTo != nil && !params.CreatedFrom.Before(*params.CreatedTo) {
+		return filter, fmt.Errorf("%w: created_from must be before created_to", ErrInvalidFilter)
+	}
+	filter.CreatedFrom = params.CreatedFrom
+	filter.CreatedTo = params.CreatedTo
+
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return filter, err
+		}
+		if cursor.Sort != filter.SortBy || cursor.Order != orderName(filter.Desc) {
+			return filter, fmt.Errorf("%w: cursor was issued for a different sort or order", ErrInvalidCursor)
+		}
+		filter.AfterID = cursor.ID
+		filter.AfterCreatedAt = cursor.CreatedAt
+	}
+
+	return filter, nil
+}
+
+func orderName(desc bool) string {
+	if desc {
+		return OrderDesc
+	}
+	return OrderAsc
+}
+
+func encodeCursor(cursor listCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string) (listCursor, error) {
+	var cursor listCursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, fmt.Errorf("%w: malformed cursor", ErrInvalidCursor)
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return cursor, fmt.Errorf("%w: malformed cursor", ErrInvalidCursor)
+	}
+	return cursor, nil
+}
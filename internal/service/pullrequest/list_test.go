@@ -0,0 +1,153 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_ListPullRequests(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	prs := []prrepo.PullRequest{
+		{PullRequestID: "pr-003", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u2"}, CreatedAt: &createdAt},
+		{PullRequestID: "pr-002", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{}, CreatedAt: &createdAt},
+		{PullRequestID: "pr-001", AuthorID: "u4", Status: "MERGED", AssignedReviewers: []string{}, CreatedAt: &createdAt},
+	}
+	nextCursor := encodeCursor(listCursor{Sort: SortCreatedAt, Order: OrderDesc, CreatedAt: createdAt, ID: "pr-002"})
+
+	tests := []struct {
+		name           string
+		params         ListPullRequestsParams
+		setupMock      func(*mockRepo)
+		expectedError  error
+		validateResult func(*testing.T, PullRequestPage)
+	}{
+		{
+			name:   "first page has next cursor",
+			params: ListPullRequestsParams{Limit: 2, AuthorID: "u1"},
+			setupMock: func(m *mockRepo) {
+				m.On("ListPullRequests", mock.Anything, prrepo.PullRequestListFilter{
+					AuthorID: "u1",
+					SortBy:   SortCreatedAt,
+					Desc:     true,
+					Limit:    3,
+				}).Return(prs, nil)
+				m.On("GetUsernames", mock.Anything, []string{"u1", "u2"}).Return(map[string]string{"u1": "Alice", "u2": "Bob"}, nil)
+			},
+			validateResult: func(t *testing.T, page PullRequestPage) {
+				assert.Len(t, page.PullRequests, 2)
+				assert.Equal(t, 2, page.Limit)
+				assert.Equal(t, nextCursor, page.NextCursor)
+				assert.Equal(t, "Alice", page.PullRequests[0].AuthorUsername)
+				assert.Equal(t, []Reviewer{{UserID: "u2", Username: "Bob"}}, page.PullRequests[0].Reviewers)
+			},
+		},
+		{
+			name:   "cursor continues listing",
+			params: ListPullRequestsParams{Limit: 2, AuthorID: "u1", Cursor: nextCursor},
+			setupMock: func(m *mockRepo) {
+				m.On("ListPullRequests", mock.Anything, prrepo.PullRequestListFilter{
+					AuthorID:       "u1",
+					SortBy:         SortCreatedAt,
+					Desc:           true,
+					AfterCreatedAt: createdAt,
+					AfterID:        "pr-002",
+					Limit:          3,
+				}).Return(prs[2:], nil)
+				m.On("GetUsernames", mock.Anything, []string{"u4"}).Return(map[string]string{}, nil)
+			},
+			validateResult: func(t *testing.T, page PullRequestPage) {
+				assert.Len(t, page.PullRequests, 1)
+				assert.Empty(t, page.NextCursor)
+			},
+		},
+		{
+			name:   "empty list",
+			params: ListPullRequestsParams{Sort: SortID, Order: OrderAsc, Status: "MERGED"},
+			setupMock: func(m *mockRepo) {
+				m.On("ListPullRequests", mock.Anything, prrepo.PullRequestListFilter{
+					Status: "MERGED",
+					SortBy: SortID,
+					Limit:  DefaultListLimit + 1,
+				}).Return(nil, nil)
+			},
+			validateResult: func(t *testing.T, page PullRequestPage) {
+				assert.NotNil(t, page.PullRequests)
+				assert.Empty(t, page.PullRequests)
+				assert.Equal(t, DefaultListLimit, page.Limit)
+			},
+		},
+		{
+			name:          "limit too large",
+			params:        ListPullRequestsParams{Limit: MaxListLimit + 1},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidPagination,
+		},
+		{
+			name:          "unknown sort",
+			params:        ListPullRequestsParams{Sort: "name"},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:          "unknown status",
+			params:        ListPullRequestsParams{Status: "CLOSED"},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:          "empty created range",
+			params:        ListPullRequestsParams{CreatedFrom: &createdAt, CreatedTo: &createdAt},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:          "malformed cursor",
+			params:        ListPullRequestsParams{Cursor: "not a cursor"},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "cursor for another order",
+			params:        ListPullRequestsParams{Order: OrderAsc, Cursor: nextCursor},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:   "database error",
+			params: ListPullRequestsParams{},
+			setupMock: func(m *mockRepo) {
+				m.On("ListPullRequests", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			page, err := service.ListPullRequests(context.Background(), tt.params)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				tt.validateResult(t, page)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
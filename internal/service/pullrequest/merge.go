@@ -2,27 +2,24 @@ package pullrequest
 
 import (
 	"context"
-	"database/sql"
-	"errors"
-)
 
-// MergePullRequest помечает PR как MERGED (идемпотентная операция)
-func (s *Service) MergePullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
-	repoPR, err := s.repo.GetPullRequest(ctx, pullRequestID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return PullRequest{}, ErrNotFound
-		}
-		return PullRequest{}, err
-	}
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
 
-	if repoPR.Status == "MERGED" {
-		pr := PullRequest{}
-		pr.FillFromDB(&repoPR)
-		return pr, nil
-	}
+// MergePullRequest помечает PR как MERGED (идемпотентная операция).
+// expectedVersion - ожидаемая версия PR, 0 - без проверки
+func (s *Service) MergePullRequest(ctx context.Context, pullRequestID string, expectedVersion int64) (PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.MergePullRequest", attribute.String("pull_request_id", pullRequestID))
+	defer span.End()
 
-	mergedPR, err := s.repo.MergePullRequest(ctx, pullRequestID)
+	mergedPR, err := s.updatePullRequest(ctx, pullRequestID, expectedVersion, func(repoPR prrepo.PullRequest) (prrepo.PullRequest, error) {
+		if repoPR.Status == "MERGED" {
+			return repoPR, nil
+		}
+		return s.repo.MergePullRequest(ctx, pullRequestID, repoPR.Version)
+	})
 	if err != nil {
 		return PullRequest{}, err
 	}
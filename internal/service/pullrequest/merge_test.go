@@ -35,7 +35,7 @@ func TestService_MergePullRequest(t *testing.T) {
 					MergedAt:          nil,
 				}, nil)
 				mergedAt := time.Now()
-				m.On("MergePullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+				m.On("MergePullRequest", mock.Anything, "pr-001", int64(0)).Return(prrepo.PullRequest{
 					PullRequestID:     "pr-001",
 					PullRequestName:   "Test PR",
 					AuthorID:          "user-001",
@@ -108,7 +108,7 @@ func TestService_MergePullRequest(t *testing.T) {
 					CreatedAt:         &createdAt,
 					MergedAt:          nil,
 				}, nil)
-				m.On("MergePullRequest", mock.Anything, "pr-004").Return(prrepo.PullRequest{}, errors.New("database error"))
+				m.On("MergePullRequest", mock.Anything, "pr-004", int64(0)).Return(prrepo.PullRequest{}, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 			validateResult: nil,
@@ -128,7 +128,7 @@ func TestService_MergePullRequest(t *testing.T) {
 					MergedAt:          nil,
 				}, nil)
 				mergedAt := time.Now()
-				m.On("MergePullRequest", mock.Anything, "pr-005").Return(prrepo.PullRequest{
+				m.On("MergePullRequest", mock.Anything, "pr-005", int64(0)).Return(prrepo.PullRequest{
 					PullRequestID:     "pr-005",
 					PullRequestName:   "Test PR 5",
 					AuthorID:          "user-001",
@@ -158,7 +158,7 @@ func TestService_MergePullRequest(t *testing.T) {
 			}
 
 			ctx := context.Background()
-			result, err := service.MergePullRequest(ctx, tt.pullRequestID)
+			result, err := service.MergePullRequest(ctx, tt.pullRequestID, 0)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -4,66 +4,109 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
-	ErrPRMerged    = errors.New("PR_MERGED")
-	ErrNotAssigned = errors.New("NOT_ASSIGNED")
-	ErrNoCandidate = errors.New("NO_CANDIDATE")
+	ErrPRMerged    = apperr.New(apperr.KindConflict, "PR_MERGED", "cannot change reviewers on merged PR")
+	ErrNotAssigned = apperr.New(apperr.KindConflict, "NOT_ASSIGNED", "reviewer is not assigned to this PR")
+	ErrNoCandidate = apperr.New(apperr.KindConflict, "NO_CANDIDATE", "no active replacement candidate in team")
 )
 
-func (s *Service) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string) (PullRequest, string, error) {
-	repoPR, err := s.repo.GetPullRequest(ctx, pullRequestID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return PullRequest{}, "", ErrNotFound
+// ReassignReviewer заменяет ревьювера на случайного активного участника команды PR.
+// expectedVersion - ожидаемая версия PR, 0 - без проверки
+func (s *Service) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string, expectedVersion int64) (PullRequest, string, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.ReassignReviewer", attribute.String("pull_request_id", pullRequestID), attribute.String("old_user_id", oldUserID))
+	defer span.End()
+
+	var newReviewerID string
+	updatedPR, err := s.updatePullRequest(ctx, pullRequestID, expectedVersion, func(repoPR prrepo.PullRequest) (prrepo.PullRequest, error) {
+		if err := checkOpen(repoPR); err != nil {
+			return prrepo.PullRequest{}, err
 		}
-		return PullRequest{}, "", err
-	}
 
-	if repoPR.Status == "MERGED" {
-		return PullRequest{}, "", ErrPRMerged
-	}
+		if !isReviewerAssigned(repoPR.AssignedReviewers, oldUserID) {
+			return prrepo.PullRequest{}, ErrNotAssigned
+		}
 
-	if !isReviewerAssigned(repoPR.AssignedReviewers, oldUserID) {
-		return PullRequest{}, "", ErrNotAssigned
-	}
+		oldReviewer, err := s.repo.GetUser(ctx, oldUserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return prrepo.PullRequest{}, fmt.Errorf("%w: user %s", ErrNotFound, oldUserID)
+			}
+			return prrepo.PullRequest{}, err
+		}
 
-	oldReviewer, err := s.repo.GetUser(ctx, oldUserID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return PullRequest{}, "", ErrNotFound
+		// замена ищется в команде PR; у PR без команды (команда удалена) - в основной команде ревьювера
+		teamName := repoPR.TeamName
+		if teamName == "" {
+			teamName = oldReviewer.TeamName
 		}
-		return PullRequest{}, "", err
-	}
 
-	candidates, err := s.findReplacementCandidates(ctx, oldReviewer.TeamName, oldUserID, repoPR.AuthorID, repoPR.AssignedReviewers)
-	if err != nil {
-		return PullRequest{}, "", err
-	}
+		candidates, err := s.findReplacementCandidates(ctx, teamName, oldUserID, repoPR.AuthorID, repoPR.AssignedReviewers)
+		if err != nil {
+			return prrepo.PullRequest{}, err
+		}
 
-	if len(candidates) == 0 {
-		return PullRequest{}, "", ErrNoCandidate
-	}
+		if len(candidates) == 0 {
+			return prrepo.PullRequest{}, ErrNoCandidate
+		}
 
-	newReviewerID, err := selectReplacementReviewer(candidates)
+		newReviewerID, err = selectReplacementReviewer(candidates)
+		if err != nil {
+			return prrepo.PullRequest{}, err
+		}
+
+		newReviewers := replaceReviewerInList(repoPR.AssignedReviewers, oldUserID, newReviewerID)
+
+		return s.repo.UpdatePullRequestReviewers(ctx, pullRequestID, repoPR.Version, newReviewers)
+	})
 	if err != nil {
 		return PullRequest{}, "", err
 	}
 
-	newReviewers := replaceReviewerInList(repoPR.AssignedReviewers, oldUserID, newReviewerID)
+	pr := PullRequest{}
+	pr.FillFromDB(&updatedPR)
+
+	return pr, newReviewerID, nil
+}
+
+// ReassignReviewerTo заменяет ревьювера на явно указанного пользователя
+func (s *Service) ReassignReviewerTo(ctx context.Context, pullRequestID, oldUserID, newUserID string, expectedVersion int64) (PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.ReassignReviewerTo", attribute.String("pull_request_id", pullRequestID), attribute.String("old_user_id", oldUserID), attribute.String("new_user_id", newUserID))
+	defer span.End()
 
-	updatedPR, err := s.repo.UpdatePullRequestReviewers(ctx, pullRequestID, newReviewers)
+	updatedPR, err := s.updatePullRequest(ctx, pullRequestID, expectedVersion, func(repoPR prrepo.PullRequest) (prrepo.PullRequest, error) {
+		if err := checkOpen(repoPR); err != nil {
+			return prrepo.PullRequest{}, err
+		}
+
+		if !isReviewerAssigned(repoPR.AssignedReviewers, oldUserID) {
+			return prrepo.PullRequest{}, ErrNotAssigned
+		}
+
+		if err := s.checkNewReviewer(ctx, repoPR, newUserID); err != nil {
+			return prrepo.PullRequest{}, err
+		}
+
+		newReviewers := replaceReviewerInList(repoPR.AssignedReviewers, oldUserID, newUserID)
+
+		return s.repo.UpdatePullRequestReviewers(ctx, pullRequestID, repoPR.Version, newReviewers)
+	})
 	if err != nil {
-		return PullRequest{}, "", err
+		return PullRequest{}, err
 	}
 
 	pr := PullRequest{}
 	pr.FillFromDB(&updatedPR)
 
-	return pr, newReviewerID, nil
+	return pr, nil
 }
 
 // isReviewerAssigned проверяет, назначен ли пользователь ревьювером PR
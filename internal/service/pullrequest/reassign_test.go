@@ -47,7 +47,7 @@ func TestService_ReassignReviewer(t *testing.T) {
 					{UserID: "user-004", Username: "david", TeamName: "backend", IsActive: true},
 					{UserID: "user-005", Username: "eve", TeamName: "backend", IsActive: true},
 				}, nil)
-				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", mock.AnythingOfType("[]string")).Return(prrepo.PullRequest{
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(0), mock.AnythingOfType("[]string")).Return(prrepo.PullRequest{
 					PullRequestID:     "pr-001",
 					PullRequestName:   "Test PR",
 					AuthorID:          "user-001",
@@ -68,6 +68,44 @@ func TestService_ReassignReviewer(t *testing.T) {
 				assert.Contains(t, pr.AssignedReviewers, replacedBy)
 			},
 		},
+		{
+			name:          "replacement is taken from the PR team",
+			pullRequestID: "pr-002",
+			oldUserID:     "user-002",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-002").Return(prrepo.PullRequest{
+					PullRequestID:     "pr-002",
+					PullRequestName:   "Test PR",
+					AuthorID:          "user-001",
+					TeamName:          "devops",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002"},
+				}, nil)
+				m.On("GetUser", mock.Anything, "user-002").Return(user.User{
+					UserID:    "user-002",
+					Username:  "bob",
+					TeamName:  "backend",
+					TeamNames: []string{"backend", "devops"},
+					IsActive:  true,
+				}, nil)
+				m.On("GetActiveTeamMembers", mock.Anything, "devops", "user-002").Return([]user.User{
+					{UserID: "user-006", Username: "frank", TeamName: "devops", IsActive: true},
+				}, nil)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-002", int64(0), []string{"user-006"}).Return(prrepo.PullRequest{
+					PullRequestID:     "pr-002",
+					PullRequestName:   "Test PR",
+					AuthorID:          "user-001",
+					TeamName:          "devops",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-006"},
+				}, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, pr PullRequest, replacedBy string) {
+				assert.Equal(t, "user-006", replacedBy)
+				assert.Equal(t, "devops", pr.TeamName)
+			},
+		},
 		{
 			name:          "PR not found",
 			pullRequestID: "pr-999",
@@ -272,7 +310,7 @@ func TestService_ReassignReviewer(t *testing.T) {
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-002").Return([]user.User{
 					{UserID: "user-004", Username: "david", TeamName: "backend", IsActive: true},
 				}, nil)
-				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-010", mock.Anything).Return(prrepo.PullRequest{}, errors.New("database error"))
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-010", int64(0), mock.Anything).Return(prrepo.PullRequest{}, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 			validateResult: nil,
@@ -301,7 +339,7 @@ func TestService_ReassignReviewer(t *testing.T) {
 				m.On("GetActiveTeamMembers", mock.Anything, "backend", "user-002").Return([]user.User{
 					{UserID: "user-004", Username: "david", TeamName: "backend", IsActive: true},
 				}, nil)
-				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-011", []string{"user-004"}).Return(prrepo.PullRequest{
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-011", int64(0), []string{"user-004"}).Return(prrepo.PullRequest{
 					PullRequestID:     "pr-011",
 					PullRequestName:   "Test PR 11",
 					AuthorID:          "user-001",
@@ -331,7 +369,7 @@ func TestService_ReassignReviewer(t *testing.T) {
 			}
 
 			ctx := context.Background()
-			result, replacedBy, err := service.ReassignReviewer(ctx, tt.pullRequestID, tt.oldUserID)
+			result, replacedBy, err := service.ReassignReviewer(ctx, tt.pullRequestID, tt.oldUserID, 0)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -360,3 +398,106 @@ func TestService_ReassignReviewer(t *testing.T) {
 	}
 }
 
+
+func TestService_ReassignReviewerTo(t *testing.T) {
+	openPR := prrepo.PullRequest{
+		PullRequestID:     "pr-001",
+		AuthorID:          "user-001",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"user-002", "user-003"},
+	}
+
+	tests := []struct {
+		name          string
+		oldUserID     string
+		newUserID     string
+		setupMock     func(*mockRepo)
+		expectedError error
+	}{
+		{
+			name:      "successful reassignment",
+			oldUserID: "user-002",
+			newUserID: "user-009",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+				m.On("GetUser", mock.Anything, "user-009").Return(user.User{UserID: "user-009", TeamName: "frontend", IsActive: true}, nil)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(0), []string{"user-009", "user-003"}).Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-009", "user-003"},
+				}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "old reviewer not assigned",
+			oldUserID: "user-005",
+			newUserID: "user-009",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+			},
+			expectedError: ErrNotAssigned,
+		},
+		{
+			name:      "new reviewer already assigned",
+			oldUserID: "user-002",
+			newUserID: "user-003",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+			},
+			expectedError: ErrAlreadyAssigned,
+		},
+		{
+			name:      "new reviewer is author",
+			oldUserID: "user-002",
+			newUserID: "user-001",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+			},
+			expectedError: ErrInvalidReviewers,
+		},
+		{
+			name:      "new reviewer inactive",
+			oldUserID: "user-002",
+			newUserID: "user-009",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR, nil)
+				m.On("GetUser", mock.Anything, "user-009").Return(user.User{UserID: "user-009", IsActive: false}, nil)
+			},
+			expectedError: ErrInvalidReviewers,
+		},
+		{
+			name:      "PR merged",
+			oldUserID: "user-002",
+			newUserID: "user-009",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{Status: "MERGED"}, nil)
+			},
+			expectedError: ErrPRMerged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.ReassignReviewerTo(context.Background(), "pr-001", tt.oldUserID, tt.newUserID, 0)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, PullRequest{}, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"user-009", "user-003"}, result.AssignedReviewers)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
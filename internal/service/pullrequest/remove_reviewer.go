@@ -0,0 +1,42 @@
+package pullrequest
+
+import (
+	"context"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RemoveReviewer снимает ревьювера с открытого PR без замены
+func (s *Service) RemoveReviewer(ctx context.Context, pullRequestID, userID string, expectedVersion int64) (PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.RemoveReviewer", attribute.String("pull_request_id", pullRequestID), attribute.String("user_id", userID))
+	defer span.End()
+
+	updatedPR, err := s.updatePullRequest(ctx, pullRequestID, expectedVersion, func(repoPR prrepo.PullRequest) (prrepo.PullRequest, error) {
+		if err := checkOpen(repoPR); err != nil {
+			return prrepo.PullRequest{}, err
+		}
+
+		if !isReviewerAssigned(repoPR.AssignedReviewers, userID) {
+			return prrepo.PullRequest{}, ErrNotAssigned
+		}
+
+		newReviewers := make([]string, 0, len(repoPR.AssignedReviewers)-1)
+		for _, reviewer := range repoPR.AssignedReviewers {
+			if reviewer != userID {
+				newReviewers = append(newReviewers, reviewer)
+			}
+		}
+
+		return s.repo.UpdatePullRequestReviewers(ctx, pullRequestID, repoPR.Version, newReviewers)
+	})
+	if err != nil {
+		return PullRequest{}, err
+	}
+
+	pr := PullRequest{}
+	pr.FillFromDB(&updatedPR)
+
+	return pr, nil
+}
@@ -0,0 +1,102 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_RemoveReviewer(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        string
+		setupMock     func(*mockRepo)
+		expectedError error
+		expectedPR    PullRequest
+	}{
+		{
+			name:   "successful remove",
+			userID: "user-002",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002", "user-003"},
+				}, nil)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(0), []string{"user-003"}).Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					AuthorID:          "user-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-003"},
+				}, nil)
+			},
+			expectedError: nil,
+			expectedPR: PullRequest{
+				PullRequestID:     "pr-001",
+				AuthorID:          "user-001",
+				Status:            "OPEN",
+				AssignedReviewers: []string{"user-003"},
+			},
+		},
+		{
+			name:   "PR not found",
+			userID: "user-002",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{}, sql.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+		{
+			name:   "PR merged",
+			userID: "user-002",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					Status:            "MERGED",
+					AssignedReviewers: []string{"user-002"},
+				}, nil)
+			},
+			expectedError: ErrPRMerged,
+		},
+		{
+			name:   "not assigned",
+			userID: "user-009",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(prrepo.PullRequest{
+					PullRequestID:     "pr-001",
+					Status:            "OPEN",
+					AssignedReviewers: []string{"user-002"},
+				}, nil)
+			},
+			expectedError: ErrNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.RemoveReviewer(context.Background(), "pr-001", tt.userID, 0)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, PullRequest{}, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedPR, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
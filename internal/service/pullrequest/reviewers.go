@@ -0,0 +1,186 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// maxReviewers сколько ревьюверов назначается на новый PR
+const maxReviewers = 2
+
+// selectReviewers подбирает ревьюверов по приоритету: запрошенные автором, владельцы измененных файлов,
+// эксперты команды по меткам PR, остальные участники команды.
+// Внутри одного уровня ревьюверы выбираются случайно, исключенные автором не назначаются
+func (s *Service) selectReviewers(ctx context.Context, teamName string, req CreatePullRequest) ([]string, error) {
+	owners, err := s.findOwners(ctx, req.Files, req.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	var teamMemberIDs []string
+	if teamName != "" {
+		teamMembers, err := s.repo.GetActiveTeamMembers(ctx, teamName, req.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range teamMembers {
+			teamMemberIDs = append(teamMemberIDs, member.UserID)
+		}
+	}
+
+	var experts []string
+	if len(req.Labels) > 0 && len(teamMemberIDs) > 0 {
+		experts, err = s.repo.GetUsersWithExpertise(ctx, teamMemberIDs, req.Labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tiers := [][]string{req.RequestedReviewers, owners, experts, teamMemberIDs}
+	return pickReviewers(tiers, maxReviewers, req.ExcludedReviewers), nil
+}
+
+// validateReviewerRequests проверяет запрошенных и исключенных ревьюверов: все должны существовать,
+// запрошенные - быть активными, не быть автором и не превышать число ревьюверов PR
+func (s *Service) validateReviewerRequests(ctx context.Context, req CreatePullRequest) error {
+	if len(req.RequestedReviewers) == 0 && len(req.ExcludedReviewers) == 0 {
+		return nil
+	}
+
+	if len(req.RequestedReviewers) > maxReviewers {
+		return fmt.Errorf("%w: at most %d reviewers can be requested", ErrInvalidReviewers, maxReviewers)
+	}
+
+	excluded := make(map[string]bool, len(req.ExcludedReviewers))
+	for _, userID := range req.ExcludedReviewers {
+		excluded[userID] = true
+	}
+	for _, userID := range req.RequestedReviewers {
+		if userID == req.AuthorId {
+			return fmt.Errorf("%w: author %s cannot be requested as a reviewer", ErrInvalidReviewers, userID)
+		}
+		if excluded[userID] {
+			return fmt.Errorf("%w: user %s is both requested and excluded", ErrInvalidReviewers, userID)
+		}
+	}
+
+	userIDs := append(append([]string{}, req.RequestedReviewers...), req.ExcludedReviewers...)
+	activity, err := s.repo.GetUsersActivity(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, userID := range userIDs {
+		if _, ok := activity[userID]; !ok {
+			missing = append(missing, userID)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %v", ErrReviewerNotFound, missing)
+	}
+
+	for _, userID := range req.RequestedReviewers {
+		if !activity[userID] {
+			return fmt.Errorf("%w: requested reviewer %s is inactive", ErrInvalidReviewers, userID)
+		}
+	}
+
+	return nil
+}
+
+// findOwners возвращает активных владельцев измененных файлов (кроме автора).
+// Владельцы путей определяются так же, как в /owners/resolve: действует последнее подходящее правило
+func (s *Service) findOwners(ctx context.Context, files []string, authorID string) ([]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	resolution, err := s.owners.ResolveOwners(ctx, files)
+	if err != nil {
+		return nil, err
+	}
+	if len(resolution.UserIDs) == 0 && len(resolution.TeamNames) == 0 {
+		return nil, nil
+	}
+
+	return s.repo.GetActiveOwners(ctx, resolution.UserIDs, resolution.TeamNames, authorID)
+}
+
+// pickReviewers набирает до limit ревьюверов, проходя уровни по порядку и выбирая случайно внутри уровня.
+// Один и тот же пользователь не назначается дважды, пользователи из excluded не назначаются
+func pickReviewers(tiers [][]string, limit int, excluded []string) []string {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	picked := make(map[string]bool)
+	for _, userID := range excluded {
+		picked[userID] = true
+	}
+
+	result := []string{}
+	for _, tier := range tiers {
+		candidates := make([]string, 0, len(tier))
+		for _, userID := range tier {
+			if !picked[userID] {
+				candidates = append(candidates, userID)
+			}
+		}
+		r.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+
+		for _, userID := range candidates {
+			if len(result) == limit {
+				return result
+			}
+			if picked[userID] {
+				continue
+			}
+			picked[userID] = true
+			result = append(result, userID)
+		}
+	}
+
+	return result
+}
+
+// normalizeFiles убирает пустые пути, ведущий слеш и повторы
+func normalizeFiles(files []string) []string {
+	return normalizeList(files, func(file string) string {
+		return strings.TrimPrefix(strings.TrimSpace(file), "/")
+	})
+}
+
+// normalizeLabels приводит метки к нижнему регистру, чтобы они совпадали с тегами экспертизы
+func normalizeLabels(labels []string) []string {
+	return normalizeList(labels, func(label string) string {
+		return strings.ToLower(strings.TrimSpace(label))
+	})
+}
+
+// dedupe убирает пустые значения и повторы, сохраняя порядок
+func dedupe(values []string) []string {
+	return normalizeList(values, strings.TrimSpace)
+}
+
+func normalizeList(values []string, normalize func(string) string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		value = normalize(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+
+	return result
+}
@@ -0,0 +1,246 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/service/owners"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_SelectReviewers(t *testing.T) {
+	teamMembers := []user.User{
+		{UserID: "u2", TeamName: "backend", IsActive: true},
+		{UserID: "u3", TeamName: "backend", IsActive: true},
+		{UserID: "u4", TeamName: "backend", IsActive: true},
+	}
+
+	tests := []struct {
+		name           string
+		files          []string
+		labels         []string
+		requested      []string
+		excluded       []string
+		setupMock      func(*mockRepo, *mockOwners)
+		expectedError  error
+		validateResult func(*testing.T, []string)
+	}{
+		{
+			name:  "owners of changed files come first",
+			files: []string{"docs/readme.md", "internal/api/server.go"},
+			setupMock: func(m *mockRepo, o *mockOwners) {
+				o.On("ResolveOwners", mock.Anything, []string{"docs/readme.md", "internal/api/server.go"}).Return(owners.Resolution{
+					UserIDs:   []string{"u9"},
+					TeamNames: []string{"backend"},
+				}, nil)
+				m.On("GetActiveOwners", mock.Anything, []string{"u9"}, []string{"backend"}, "u1").Return([]string{"u2", "u9"}, nil)
+				m.On("GetActiveTeamMembers", mock.Anything, "backend", "u1").Return(teamMembers, nil)
+			},
+			validateResult: func(t *testing.T, reviewers []string) {
+				assert.ElementsMatch(t, []string{"u2", "u9"}, reviewers)
+			},
+		},
+		{
+			name:  "owner from resolver before team members",
+			files: []string{"docs/readme.md"},
+			setupMock: func(m *mockRepo, o *mockOwners) {
+				o.On("ResolveOwners", mock.Anything, []string{"docs/readme.md"}).Return(owners.Resolution{
+					UserIDs:   []string{"u9"},
+					TeamNames: []string{},
+				}, nil)
+				m.On("GetActiveOwners", mock.Anything, []string{"u9"}, []string{}, "u1").Return([]string{"u9"}, nil)
+				m.On("GetActiveTeamMembers", mock.Anything, "backend", "u1").Return(teamMembers, nil)
+			},
+			validateResult: func(t *testing.T, reviewers []string) {
+				assert.Len(t, reviewers, 2)
+				assert.Equal(t, "u9", reviewers[0])
+			},
+		},
+		{
+			name:   "experts by labels before other team members",
+			labels: []string{"go"},
+			setupMock: func(m *mockRepo, o *mockOwners) {
+				m.On("GetActiveTeamMembers", mock.Anything, "backend", "u1").Return(teamMembers, nil)
+				m.On("GetUsersWithExpertise", mock.Anything, []string{"u2", "u3", "u4"}, []string{"go"}).Return([]string{"u3"}, nil)
+			},
+			validateResult: func(t *testing.T, reviewers []string) {
+				assert.Len(t, reviewers, 2)
+				assert.Equal(t, "u3", reviewers[0])
+			},
+		},
+		{
+			name:  "files without owners fall back to team",
+			files: []string{"main.go"},
+			setupMock: func(m *mockRepo, o *mockOwners) {
+				o.On("ResolveOwners", mock.Anything, []string{"main.go"}).Return(owners.Resolution{
+					UserIDs:   []string{},
+					TeamNames: []string{},
+				}, nil)
+				m.On("GetActiveTeamMembers", mock.Anything, "backend", "u1").Return(teamMembers, nil)
+			},
+			validateResult: func(t *testing.T, reviewers []string) {
+				assert.Len(t, reviewers, 2)
+				assert.NotContains(t, reviewers, "u9")
+			},
+		},
+		{
+			name:      "requested reviewer comes first, excluded is skipped",
+			labels:    []string{"go"},
+			requested: []string{"u7"},
+			excluded:  []string{"u3"},
+			setupMock: func(m *mockRepo, o *mockOwners) {
+				m.On("GetActiveTeamMembers", mock.Anything, "backend", "u1").Return(teamMembers, nil)
+				m.On("GetUsersWithExpertise", mock.Anything, []string{"u2", "u3", "u4"}, []string{"go"}).Return([]string{"u3"}, nil)
+			},
+			validateResult: func(t *testing.T, reviewers []string) {
+				assert.Len(t, reviewers, 2)
+				assert.Equal(t, "u7", reviewers[0])
+				assert.NotContains(t, reviewers, "u3")
+			},
+		},
+		{
+			name:  "error resolving owners",
+			files: []string{"main.go"},
+			setupMock: func(m *mockRepo, o *mockOwners) {
+				o.On("ResolveOwners", mock.Anything, []string{"main.go"}).Return(owners.Resolution{}, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			mockOwners := new(mockOwners)
+			tt.setupMock(mockRepo, mockOwners)
+
+			service := &Service{
+				repo:   mockRepo,
+				owners: mockOwners,
+			}
+
+			reviewers, err := service.selectReviewers(context.Background(), "backend", CreatePullRequest{
+				AuthorId:           "u1",
+				Files:              tt.files,
+				Labels:             tt.labels,
+				RequestedReviewers: tt.requested,
+				ExcludedReviewers:  tt.excluded,
+			})
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				tt.validateResult(t, reviewers)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockOwners.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPickReviewers(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, pickReviewers([][]string{{"a"}, {"a", "b"}}, 2, nil))
+	assert.Equal(t, []string{}, pickReviewers([][]string{nil, {}}, 2, nil))
+	assert.Len(t, pickReviewers([][]string{{"a", "b", "c"}}, 2, nil), 2)
+	assert.Equal(t, []string{"a", "c"}, pickReviewers([][]string{{"a"}, {"b"}, {"c"}}, 2, []string{"b"}))
+	assert.Equal(t, []string{}, pickReviewers([][]string{{"a"}}, 2, []string{"a"}))
+}
+
+func TestService_ValidateReviewerRequests(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       CreatePullRequest
+		setupMock     func(*mockRepo)
+		expectedError error
+		errorContains string
+	}{
+		{
+			name:          "nothing requested",
+			request:       CreatePullRequest{AuthorId: "u1"},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: nil,
+		},
+		{
+			name:    "valid requests",
+			request: CreatePullRequest{AuthorId: "u1", RequestedReviewers: []string{"u2"}, ExcludedReviewers: []string{"u3"}},
+			setupMock: func(m *mockRepo) {
+				m.On("GetUsersActivity", mock.Anything, []string{"u2", "u3"}).Return(map[string]bool{"u2": true, "u3": false}, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "too many requested",
+			request:       CreatePullRequest{AuthorId: "u1", RequestedReviewers: []string{"u2", "u3", "u4"}},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidReviewers,
+			errorContains: "at most 2",
+		},
+		{
+			name:          "author requested",
+			request:       CreatePullRequest{AuthorId: "u1", RequestedReviewers: []string{"u1"}},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidReviewers,
+			errorContains: "author u1",
+		},
+		{
+			name:          "requested and excluded",
+			request:       CreatePullRequest{AuthorId: "u1", RequestedReviewers: []string{"u2"}, ExcludedReviewers: []string{"u2"}},
+			setupMock:     func(m *mockRepo) {},
+			expectedError: ErrInvalidReviewers,
+			errorContains: "both requested and excluded",
+		},
+		{
+			name:    "unknown users",
+			request: CreatePullRequest{AuthorId: "u1", RequestedReviewers: []string{"ghost"}, ExcludedReviewers: []string{"u3", "nobody"}},
+			setupMock: func(m *mockRepo) {
+				m.On("GetUsersActivity", mock.Anything, []string{"ghost", "u3", "nobody"}).Return(map[string]bool{"u3": true}, nil)
+			},
+			expectedError: ErrReviewerNotFound,
+			errorContains: "[ghost nobody]",
+		},
+		{
+			name:    "inactive requested reviewer",
+			request: CreatePullRequest{AuthorId: "u1", RequestedReviewers: []string{"u2"}},
+			setupMock: func(m *mockRepo) {
+				m.On("GetUsersActivity", mock.Anything, []string{"u2"}).Return(map[string]bool{"u2": false}, nil)
+			},
+			expectedError: ErrInvalidReviewers,
+			errorContains: "u2 is inactive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			err := service.validateReviewerRequests(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Contains(t, err.Error(), tt.errorContains)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNormalizeFilesAndLabels(t *testing.T) {
+	assert.Equal(t, []string{"src/main.go", "README.md"}, normalizeFiles([]string{"/src/main.go", " README.md ", "", "src/main.go"}))
+	assert.Equal(t, []string{"go", "db"}, normalizeLabels([]string{"Go", "db", "GO "}))
+	assert.Nil(t, normalizeLabels(nil))
+	assert.Equal(t, []string{"u2", "u3"}, dedupe([]string{"u2", " u3", "u2", ""}))
+}
@@ -2,12 +2,14 @@ package pullrequest
 
 // Service структура для бизнес-логики pull requests
 type Service struct {
-	repo Repo
+	repo   Repo
+	owners OwnersResolver
 }
 
-// NewService создает новый Service
-func NewService(repo Repo) *Service {
+// NewService создает новый Service; владельцы измененных файлов определяются через owners
+func NewService(repo Repo, owners OwnersResolver) *Service {
 	return &Service{
-		repo: repo,
+		repo:   repo,
+		owners: owners,
 	}
 }
@@ -2,6 +2,8 @@ package pullrequest
 
 import (
 	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
 )
 
 type ReviewerStats struct {
@@ -23,6 +25,9 @@ type Stats struct {
 }
 
 func (s *Service) GetStats(ctx context.Context) (Stats, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.Service.GetStats")
+	defer span.End()
+
 	prStats, err := s.repo.GetPRStats(ctx)
 	if err != nil {
 		return Stats{}, err
@@ -0,0 +1,67 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrVersionMismatch  = apperr.New(apperr.KindPreconditionFailed, "VERSION_MISMATCH", "PR version does not match If-Match")
+	ErrConcurrentUpdate = apperr.New(apperr.KindConflict, "CONCURRENT_UPDATE", "concurrent update")
+)
+
+// maxUpdateAttempts сколько раз изменение PR повторяется при параллельных обновлениях
+const maxUpdateAttempts = 3
+
+// updatePullRequest читает PR и применяет к нему update, который сохраняет изменение с проверкой версии.
+// expectedVersion - версия, которую видел клиент (0 - без проверки): если PR уже изменился,
+// возвращается ErrVersionMismatch. Без expectedVersion конфликт с параллельным обновлением
+// разрешается повтором на свежих данных, после maxUpdateAttempts возвращается ErrConcurrentUpdate
+func (s *Service) updatePullRequest(ctx context.Context, pullRequestID string, expectedVersion int64,
+	update func(repoPR prrepo.PullRequest) (prrepo.PullRequest, error)) (prrepo.PullRequest, error) {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		repoPR, err := s.repo.GetPullRequest(ctx, pullRequestID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return prrepo.PullRequest{}, fmt.Errorf("%w: PR %s", ErrNotFound, pullRequestID)
+			}
+			return prrepo.PullRequest{}, err
+		}
+
+		if expectedVersion != 0 && repoPR.Version != expectedVersion {
+			return prrepo.PullRequest{}, fmt.Errorf("%w: current version is %d", ErrVersionMismatch, repoPR.Version)
+		}
+
+		updatedPR, err := update(repoPR)
+		if errors.Is(err, store.ErrConflict) {
+			if expectedVersion != 0 {
+				return prrepo.PullRequest{}, fmt.Errorf("%w: PR was modified concurrently", ErrVersionMismatch)
+			}
+			logging.FromContext(ctx).WithFields(logrus.Fields{
+				"pull_request_id": pullRequestID,
+				"version":         repoPR.Version,
+				"attempt":         attempt + 1,
+			}).Warn("Pull request was modified concurrently, retrying update")
+			continue
+		}
+		return updatedPR, err
+	}
+
+	return prrepo.PullRequest{}, fmt.Errorf("%w: PR %s was modified concurrently %d times", ErrConcurrentUpdate, pullRequestID, maxUpdateAttempts)
+}
+
+// checkOpen проверяет, что PR еще не смержен
+func checkOpen(repoPR prrepo.PullRequest) error {
+	if repoPR.Status == "MERGED" {
+		return ErrPRMerged
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	prrepo "github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_UpdatePullRequest(t *testing.T) {
+	openPR := func(version int64) prrepo.PullRequest {
+		return prrepo.PullRequest{
+			PullRequestID:     "pr-001",
+			AuthorID:          "user-001",
+			Status:            "OPEN",
+			AssignedReviewers: []string{"user-002", "user-003"},
+			Version:           version,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		expectedVersion int64
+		setupMock       func(*mockRepo)
+		expectedError   error
+		resultVersion   int64
+	}{
+		{
+			name:            "expected version matches",
+			expectedVersion: 3,
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR(3), nil)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(3), []string{"user-003"}).Return(openPR(4), nil)
+			},
+			resultVersion: 4,
+		},
+		{
+			name:            "stale expected version",
+			expectedVersion: 2,
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR(3), nil)
+			},
+			expectedError: ErrVersionMismatch,
+		},
+		{
+			name:            "conflict with expected version is not retried",
+			expectedVersion: 3,
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR(3), nil).Once()
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(3), []string{"user-003"}).Return(nil, store.ErrConflict).Once()
+			},
+			expectedError: ErrVersionMismatch,
+		},
+		{
+			name: "conflict without expected version is retried on fresh data",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR(3), nil).Once()
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(3), []string{"user-003"}).Return(nil, store.ErrConflict).Once()
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR(4), nil).Once()
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(4), []string{"user-003"}).Return(openPR(5), nil).Once()
+			},
+			resultVersion: 5,
+		},
+		{
+			name: "retries exhausted",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(openPR(3), nil).Times(maxUpdateAttempts)
+				m.On("UpdatePullRequestReviewers", mock.Anything, "pr-001", int64(3), []string{"user-003"}).Return(nil, store.ErrConflict).Times(maxUpdateAttempts)
+			},
+			expectedError: ErrConcurrentUpdate,
+		},
+		{
+			name: "PR not found",
+			setupMock: func(m *mockRepo) {
+				m.On("GetPullRequest", mock.Anything, "pr-001").Return(nil, sql.ErrNoRows)
+			},
+			expectedError: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.RemoveReviewer(context.Background(), "pr-001", "user-002", tt.expectedVersion)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.resultVersion, result.Version)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -10,8 +10,10 @@ type Repo interface {
 	TeamExists(ctx context.Context, teamName string) (bool, error)
 	CreateTeam(ctx context.Context, teamName string) error
 	GetTeam(ctx context.Context, teamName string) (string, []team.User, error)
+	ListTeams(ctx context.Context, filter team.TeamListFilter) ([]team.TeamSummary, error)
+	CountTeams(ctx context.Context, prefix string) (int, error)
 	UserExists(ctx context.Context, userID string) (bool, error)
-	CreateUser(ctx context.Context, userID, username, teamName string, isActive bool) error
-	UpdateUser(ctx context.Context, userID, username, teamName string, isActive bool) error
+	CreateUser(ctx context.Context, userID, username string, isActive bool) error
+	UpdateUser(ctx context.Context, userID, username string, isActive bool) error
 	BeginTx(ctx context.Context) (team.Tx, error)
 }
@@ -3,13 +3,23 @@ package team
 import (
 	"context"
 	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
-	ErrTeamExists = errors.New("TEAM_EXISTS")
+	ErrTeamExists = apperr.New(apperr.KindInvalid, "TEAM_EXISTS", "team_name already exists")
+	// ErrUserExists пользователь создан параллельным запросом между проверкой и вставкой; запрос можно повторить
+	ErrUserExists = apperr.New(apperr.KindConflict, "USER_EXISTS", "user_id already exists")
 )
 
 func (s *Service) CreateTeam(ctx context.Context, team Team) (Team, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.CreateTeam", attribute.String("team_name", team.TeamName))
+	defer span.End()
+
 	exists, err := s.repo.TeamExists(ctx, team.TeamName)
 	if err != nil {
 		return Team{}, err
@@ -25,29 +35,44 @@ func (s *Service) CreateTeam(ctx context.Context, team Team) (Team, error) {
 	defer tx.Rollback()
 
 	if err := tx.CreateTeam(team.TeamName); err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return Team{}, ErrTeamExists
+		}
 		return Team{}, err
 	}
 
-	for _, member := range team.Members {
+	result := Team{
+		TeamName: team.TeamName,
+		Members:  make([]TeamMember, len(team.Members)),
+	}
+
+	// существующие пользователи остаются и в своих прежних командах
+	for i, member := range team.Members {
 		userExists, err := s.repo.UserExists(ctx, member.UserID)
 		if err != nil {
 			return Team{}, err
 		}
 
 		if userExists {
-			if err := tx.UpdateUser(member.UserID, member.Username, team.TeamName, member.IsActive); err != nil {
+			if err := tx.UpdateUser(member.UserID, member.Username, member.IsActive); err != nil {
 				return Team{}, err
 			}
 		} else {
-			if err := tx.CreateUser(member.UserID, member.Username, team.TeamName, member.IsActive); err != nil {
+			if err := createUser(tx, member.UserID, member.Username, member.IsActive); err != nil {
 				return Team{}, err
 			}
 		}
+
+		member.Role = memberRole(member.Role)
+		if err := tx.AddMember(team.TeamName, member.UserID, member.Role); err != nil {
+			return Team{}, err
+		}
+		result.Members[i] = member
 	}
 
 	if err := tx.Commit(); err != nil {
 		return Team{}, err
 	}
 
-	return team, nil
+	return result, nil
 }
@@ -3,9 +3,11 @@ package team
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -35,18 +37,31 @@ func (m *mockRepo) GetTeam(ctx context.Context, teamName string) (string, []team
 	return args.String(0), args.Get(1).([]team.User), args.Error(2)
 }
 
+func (m *mockRepo) ListTeams(ctx context.Context, filter team.TeamListFilter) ([]team.TeamSummary, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]team.TeamSummary), args.Error(1)
+}
+
+func (m *mockRepo) CountTeams(ctx context.Context, prefix string) (int, error) {
+	args := m.Called(ctx, prefix)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *mockRepo) UserExists(ctx context.Context, userID string) (bool, error) {
 	args := m.Called(ctx, userID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *mockRepo) CreateUser(ctx context.Context, userID, username, teamName string, isActive bool) error {
-	args := m.Called(ctx, userID, username, teamName, isActive)
+func (m *mockRepo) CreateUser(ctx context.Context, userID, username string, isActive bool) error {
+	args := m.Called(ctx, userID, username, isActive)
 	return args.Error(0)
 }
 
-func (m *mockRepo) UpdateUser(ctx context.Context, userID, username, teamName string, isActive bool) error {
-	args := m.Called(ctx, userID, username, teamName, isActive)
+func (m *mockRepo) UpdateUser(ctx context.Context, userID, username string, isActive bool) error {
+	args := m.Called(ctx, userID, username, isActive)
 	return args.Error(0)
 }
 
@@ -67,13 +82,83 @@ func (m *mockTx) CreateTeam(teamName string) error {
 	return args.Error(0)
 }
 
-func (m *mockTx) CreateUser(userID, username, teamName string, isActive bool) error {
-	args := m.Called(userID, username, teamName, isActive)
+func (m *mockTx) CreateUser(userID, username string, isActive bool) error {
+	args := m.Called(userID, username, isActive)
+	return args.Error(0)
+}
+
+func (m *mockTx) UpdateUser(userID, username string, isActive bool) error {
+	args := m.Called(userID, username, isActive)
+	return args.Error(0)
+}
+
+func (m *mockTx) AddMember(teamName, userID, role string) error {
+	args := m.Called(teamName, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockTx) LockTeam(teamName string) (bool, error) {
+	args := m.Called(teamName)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockTx) LockTeams(teamNames []string) ([]string, error) {
+	args := m.Called(teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockTx) GetMemberships(userIDs, teamNames []string) ([]team.User, error) {
+	args := m.Called(userIDs, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]team.User), args.Error(1)
+}
+
+func (m *mockTx) GetTeamMembers(teamName string) ([]team.User, error) {
+	args := m.Called(teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]team.User), args.Error(1)
+}
+
+func (m *mockTx) GetUsers(userIDs []string) ([]team.User, error) {
+	args := m.Called(userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]team.User), args.Error(1)
+}
+
+func (m *mockTx) RemoveMembers(teamName string, userIDs []string) error {
+	args := m.Called(teamName, userIDs)
+	return args.Error(0)
+}
+
+func (m *mockTx) RenameTeam(teamName, newTeamName string) error {
+	args := m.Called(teamName, newTeamName)
 	return args.Error(0)
 }
 
-func (m *mockTx) UpdateUser(userID, username, teamName string, isActive bool) error {
-	args := m.Called(userID, username, teamName, isActive)
+func (m *mockTx) DeleteTeam(teamName string) error {
+	args := m.Called(teamName)
+	return args.Error(0)
+}
+
+func (m *mockTx) GetOpenReviews(reviewerIDs []string) ([]team.OpenReview, error) {
+	args := m.Called(reviewerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]team.OpenReview), args.Error(1)
+}
+
+func (m *mockTx) UpdateReviewers(pullRequestID string, reviewers []string) error {
+	args := m.Called(pullRequestID, reviewers)
 	return args.Error(0)
 }
 
@@ -109,9 +194,11 @@ func TestService_CreateTeam(t *testing.T) {
 				m.On("BeginTx", mock.Anything).Return(tx, nil)
 				tx.On("CreateTeam", "backend").Return(nil)
 				m.On("UserExists", mock.Anything, "user-001").Return(false, nil)
-				tx.On("CreateUser", "user-001", "alice", "backend", true).Return(nil)
+				tx.On("CreateUser", "user-001", "alice", true).Return(nil)
+				tx.On("AddMember", "backend", "user-001", RoleMember).Return(nil)
 				m.On("UserExists", mock.Anything, "user-002").Return(false, nil)
-				tx.On("CreateUser", "user-002", "bob", "backend", true).Return(nil)
+				tx.On("CreateUser", "user-002", "bob", true).Return(nil)
+				tx.On("AddMember", "backend", "user-002", RoleMember).Return(nil)
 				tx.On("Commit").Return(nil)
 				tx.On("Rollback").Return(nil)
 			},
@@ -134,7 +221,8 @@ func TestService_CreateTeam(t *testing.T) {
 				m.On("BeginTx", mock.Anything).Return(tx, nil)
 				tx.On("CreateTeam", "frontend").Return(nil)
 				m.On("UserExists", mock.Anything, "user-003").Return(true, nil)
-				tx.On("UpdateUser", "user-003", "charlie", "frontend", true).Return(nil)
+				tx.On("UpdateUser", "user-003", "charlie", true).Return(nil)
+				tx.On("AddMember", "frontend", "user-003", RoleMember).Return(nil)
 				tx.On("Commit").Return(nil)
 				tx.On("Rollback").Return(nil)
 			},
@@ -158,9 +246,11 @@ func TestService_CreateTeam(t *testing.T) {
 				m.On("BeginTx", mock.Anything).Return(tx, nil)
 				tx.On("CreateTeam", "devops").Return(nil)
 				m.On("UserExists", mock.Anything, "user-004").Return(false, nil)
-				tx.On("CreateUser", "user-004", "david", "devops", true).Return(nil)
+				tx.On("CreateUser", "user-004", "david", true).Return(nil)
+				tx.On("AddMember", "devops", "user-004", RoleMember).Return(nil)
 				m.On("UserExists", mock.Anything, "user-005").Return(true, nil)
-				tx.On("UpdateUser", "user-005", "eve", "devops", false).Return(nil)
+				tx.On("UpdateUser", "user-005", "eve", false).Return(nil)
+				tx.On("AddMember", "devops", "user-005", RoleMember).Return(nil)
 				tx.On("Commit").Return(nil)
 				tx.On("Rollback").Return(nil)
 			},
@@ -170,6 +260,29 @@ func TestService_CreateTeam(t *testing.T) {
 				assert.Len(t, team.Members, 2)
 			},
 		},
+		{
+			name: "lead role is kept",
+			team: Team{
+				TeamName: "qa",
+				Members: []TeamMember{
+					{UserID: "user-006", Username: "frank", Role: RoleLead, IsActive: true},
+				},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("TeamExists", mock.Anything, "qa").Return(false, nil)
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("CreateTeam", "qa").Return(nil)
+				m.On("UserExists", mock.Anything, "user-006").Return(false, nil)
+				tx.On("CreateUser", "user-006", "frank", true).Return(nil)
+				tx.On("AddMember", "qa", "user-006", RoleLead).Return(nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, team Team) {
+				assert.Equal(t, RoleLead, team.Members[0].Role)
+			},
+		},
 		{
 			name: "team already exists",
 			team: Team{
@@ -261,12 +374,31 @@ func TestService_CreateTeam(t *testing.T) {
 				m.On("BeginTx", mock.Anything).Return(tx, nil)
 				tx.On("CreateTeam", "backend").Return(nil)
 				m.On("UserExists", mock.Anything, "user-001").Return(false, nil)
-				tx.On("CreateUser", "user-001", "alice", "backend", true).Return(errors.New("database error"))
+				tx.On("CreateUser", "user-001", "alice", true).Return(errors.New("database error"))
 				tx.On("Rollback").Return(nil)
 			},
 			expectedError: errors.New("database error"),
 			validateResult: nil,
 		},
+		{
+			name: "user created concurrently",
+			team: Team{
+				TeamName: "backend",
+				Members: []TeamMember{
+					{UserID: "user-001", Username: "alice", IsActive: true},
+				},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("TeamExists", mock.Anything, "backend").Return(false, nil)
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("CreateTeam", "backend").Return(nil)
+				m.On("UserExists", mock.Anything, "user-001").Return(false, nil)
+				tx.On("CreateUser", "user-001", "alice", true).Return(fmt.Errorf("%w: users_pkey", store.ErrDuplicateKey))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrUserExists,
+			validateResult: nil,
+		},
 		{
 			name: "error updating user in transaction",
 			team: Team{
@@ -280,7 +412,27 @@ func TestService_CreateTeam(t *testing.T) {
 				m.On("BeginTx", mock.Anything).Return(tx, nil)
 				tx.On("CreateTeam", "backend").Return(nil)
 				m.On("UserExists", mock.Anything, "user-001").Return(true, nil)
-				tx.On("UpdateUser", "user-001", "alice", "backend", true).Return(errors.New("database error"))
+				tx.On("UpdateUser", "user-001", "alice", true).Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+			validateResult: nil,
+		},
+		{
+			name: "error adding member in transaction",
+			team: Team{
+				TeamName: "backend",
+				Members: []TeamMember{
+					{UserID: "user-001", Username: "alice", IsActive: true},
+				},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("TeamExists", mock.Anything, "backend").Return(false, nil)
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("CreateTeam", "backend").Return(nil)
+				m.On("UserExists", mock.Anything, "user-001").Return(true, nil)
+				tx.On("UpdateUser", "user-001", "alice", true).Return(nil)
+				tx.On("AddMember", "backend", "user-001", RoleMember).Return(errors.New("database error"))
 				tx.On("Rollback").Return(nil)
 			},
 			expectedError: errors.New("database error"),
@@ -299,7 +451,8 @@ func TestService_CreateTeam(t *testing.T) {
 				m.On("BeginTx", mock.Anything).Return(tx, nil)
 				tx.On("CreateTeam", "backend").Return(nil)
 				m.On("UserExists", mock.Anything, "user-001").Return(false, nil)
-				tx.On("CreateUser", "user-001", "alice", "backend", true).Return(nil)
+				tx.On("CreateUser", "user-001", "alice", true).Return(nil)
+				tx.On("AddMember", "backend", "user-001", RoleMember).Return(nil)
 				tx.On("Commit").Return(errors.New("commit error"))
 				tx.On("Rollback").Return(nil)
 			},
@@ -342,8 +495,8 @@ func TestService_CreateTeam(t *testing.T) {
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
-				if errors.Is(tt.expectedError, ErrTeamExists) {
-					assert.ErrorIs(t, err, ErrTeamExists)
+				if errors.Is(tt.expectedError, ErrTeamExists) || errors.Is(tt.expectedError, ErrUserExists) {
+					assert.ErrorIs(t, err, tt.expectedError)
 				} else {
 					assert.Contains(t, err.Error(), tt.expectedError.Error())
 				}
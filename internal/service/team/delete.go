@@ -0,0 +1,75 @@
+package team
+
+import (
+	"context"
+
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type DeleteTeamResult struct {
+	TeamName          string
+	DetachedUserIDs   []string
+	ReassignedReviews []ReassignedReview
+}
+
+// DeleteTeam удаляет команду вместе с членствами; PR команды остаются без команды.
+// Участники снимаются с ревью открытых PR команды в той же транзакции: замену искать
+// не среди кого, все участники уходят вместе.
+func (s *Service) DeleteTeam(ctx context.Context, teamName string) (DeleteTeamResult, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.DeleteTeam", attribute.String("team_name", teamName))
+	defer span.End()
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return DeleteTeamResult{}, err
+	}
+	defer tx.Rollback()
+
+	exists, err := tx.LockTeam(teamName)
+	if err != nil {
+		return DeleteTeamResult{}, err
+	}
+	if !exists {
+		return DeleteTeamResult{}, ErrTeamNotFound
+	}
+
+	members, err := tx.GetTeamMembers(teamName)
+	if err != nil {
+		return DeleteTeamResult{}, err
+	}
+	detached := make([]string, len(members))
+	for i, m := range members {
+		detached[i] = m.UserID
+	}
+
+	// ревью снимаются до удаления: после него у PR нет команды и reassignReviews их не найдет
+	reassigned := make([]ReassignedReview, 0)
+	if len(detached) > 0 {
+		if err := tx.RemoveMembers(teamName, detached); err != nil {
+			return DeleteTeamResult{}, err
+		}
+		reassigned, err = s.reassignReviews(tx, teamName, detached)
+		if err != nil {
+			return DeleteTeamResult{}, err
+		}
+	}
+
+	if err := tx.DeleteTeam(teamName); err != nil {
+		return DeleteTeamResult{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return DeleteTeamResult{}, err
+	}
+
+	logging.FromContext(ctx).WithField("team_name", teamName).WithField("detached_users", len(detached)).
+		WithField("removed_reviews", len(reassigned)).Info("Team deleted")
+
+	return DeleteTeamResult{
+		TeamName:          teamName,
+		DetachedUserIDs:   detached,
+		ReassignedReviews: reassigned,
+	}, nil
+}
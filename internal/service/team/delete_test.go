@@ -0,0 +1,149 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_DeleteTeam(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamName       string
+		setupMock      func(*mockRepo, *mockTx)
+		expectedError  error
+		expectedResult DeleteTeamResult
+	}{
+		{
+			name:     "successful delete detaches members",
+			teamName: "backend",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: false},
+				}, nil).Once()
+				tx.On("RemoveMembers", "backend", []string{"u1", "u2"}).Return(nil)
+				tx.On("GetOpenReviews", []string{"u1", "u2"}).Return([]team.OpenReview{}, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{}, nil).Once()
+				tx.On("DeleteTeam", "backend").Return(nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			expectedResult: DeleteTeamResult{
+				TeamName:          "backend",
+				DetachedUserIDs:   []string{"u1", "u2"},
+				ReassignedReviews: []ReassignedReview{},
+			},
+		},
+		{
+			name:     "members are removed from open reviews of the team",
+			teamName: "backend",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+				}, nil).Once()
+				tx.On("RemoveMembers", "backend", []string{"u1", "u2"}).Return(nil)
+				tx.On("GetOpenReviews", []string{"u1", "u2"}).Return([]team.OpenReview{
+					{PullRequestID: "pr-1", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2", "u7"}},
+					// PR другой команды, где u2 тоже ревьювер, не трогается
+					{PullRequestID: "pr-2", AuthorID: "u9", TeamName: "frontend", AssignedReviewers: []string{"u2"}},
+				}, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{}, nil).Once()
+				tx.On("UpdateReviewers", "pr-1", []string{"u7"}).Return(nil)
+				tx.On("DeleteTeam", "backend").Return(nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			expectedResult: DeleteTeamResult{
+				TeamName:        "backend",
+				DetachedUserIDs: []string{"u1", "u2"},
+				ReassignedReviews: []ReassignedReview{
+					{PullRequestID: "pr-1", OldReviewerID: "u2"},
+				},
+			},
+		},
+		{
+			name:     "error updating reviewers",
+			teamName: "backend",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+				}, nil).Once()
+				tx.On("RemoveMembers", "backend", []string{"u2"}).Return(nil)
+				tx.On("GetOpenReviews", []string{"u2"}).Return([]team.OpenReview{
+					{PullRequestID: "pr-1", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2"}},
+				}, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{}, nil).Once()
+				tx.On("UpdateReviewers", "pr-1", []string{}).Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:     "team not found",
+			teamName: "nonexistent",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "nonexistent").Return(false, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:     "error deleting team",
+			teamName: "backend",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{}, nil)
+				tx.On("DeleteTeam", "backend").Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			mockTx := new(mockTx)
+			tt.setupMock(mockRepo, mockTx)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			ctx := context.Background()
+			result, err := service.DeleteTeam(ctx, tt.teamName)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrTeamNotFound) {
+					assert.ErrorIs(t, err, ErrTeamNotFound)
+				} else {
+					assert.Contains(t, err.Error(), tt.expectedError.Error())
+				}
+				assert.Equal(t, DeleteTeamResult{}, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+		})
+	}
+}
@@ -1,8 +1,14 @@
 package team
 
+const (
+	RoleMember = "member"
+	RoleLead   = "lead"
+)
+
 type TeamMember struct {
 	UserID   string
 	Username string
+	Role     string
 	IsActive bool
 }
 
@@ -10,3 +16,33 @@ type Team struct {
 	TeamName string
 	Members  []TeamMember
 }
+
+type TeamSummary struct {
+	TeamName           string
+	MembersCount       int
+	ActiveMembersCount int
+	OpenPRsCount       int
+}
+
+// ListTeamsParams параметры списка команд; нулевой Limit означает DefaultListLimit
+type ListTeamsParams struct {
+	Prefix string
+	Limit  int
+	Offset int
+}
+
+// TeamList страница списка команд; Total - число команд с заданным префиксом без учёта страницы
+type TeamList struct {
+	Teams  []TeamSummary
+	Total  int
+	Limit  int
+	Offset int
+}
+
+// memberRole возвращает роль участника, по умолчанию RoleMember
+func memberRole(role string) string {
+	if role == "" {
+		return RoleMember
+	}
+	return role
+}
@@ -4,13 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
-	ErrTeamNotFound = errors.New("NOT_FOUND")
+	ErrTeamNotFound = apperr.New(apperr.KindNotFound, "NOT_FOUND", "team not found")
 )
 
 func (s *Service) GetTeam(ctx context.Context, teamName string) (Team, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.GetTeam", attribute.String("team_name", teamName))
+	defer span.End()
+
 	teamNameDB, repoUsers, err := s.repo.GetTeam(ctx, teamName)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -24,6 +31,7 @@ func (s *Service) GetTeam(ctx context.Context, teamName string) (Team, error) {
 		members[i] = TeamMember{
 			UserID:   u.UserID,
 			Username: u.Username,
+			Role:     u.Role,
 			IsActive: u.IsActive,
 		}
 	}
@@ -0,0 +1,386 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrInvalidRoster = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid roster")
+)
+
+type ImportUser struct {
+	UserID   string
+	Username string
+	IsActive bool
+}
+
+// Membership членство пользователя в команде, добавляемое или снимаемое импортом
+type Membership struct {
+	TeamName string
+	UserID   string
+	Username string
+}
+
+// ImportDiff изменения, которые импорт вносит (или внес бы при dry run) в текущее состояние.
+// ReassignedReviews - замены ревьюверов, исключенных из команд или ставших неактивными
+type ImportDiff struct {
+	CreatedTeams      []string
+	CreatedUsers      []ImportUser
+	UpdatedUsers      []ImportUser
+	AddedMembers      []Membership
+	RemovedMembers    []Membership
+	DeactivatedUsers  []ImportUser
+	ReassignedReviews []ReassignedReview
+}
+
+type ImportResult struct {
+	Diff    ImportDiff
+	Applied bool
+}
+
+func (d *ImportDiff) Empty() bool {
+	return len(d.CreatedTeams) == 0 &&
+		len(d.CreatedUsers) == 0 &&
+		len(d.UpdatedUsers) == 0 &&
+		len(d.AddedMembers) == 0 &&
+		len(d.RemovedMembers) == 0 &&
+		len(d.DeactivatedUsers) == 0
+}
+
+// ImportTeams синхронизирует перечисленные команды с переданным составом:
+// создает недостающие команды и пользователей, обновляет имена и активность,
+// добавляет и снимает членства. Пользователь, исключенный из команды и не оставшийся
+// ни в одной команде, деактивируется. Команды, отсутствующие в teams, не затрагиваются.
+// Открытые ревью исключенных и ставших неактивными пользователей переназначаются в той же транзакции.
+// Разница считается и применяется в одной транзакции под блокировкой команд и пользователей,
+// и параллельные изменения состава не делают ее устаревшей. Dry run применяет изменения и откатывает
+// транзакцию, поэтому показывает и переназначения; замены при реальном импорте выбираются заново.
+func (s *Service) ImportTeams(ctx context.Context, teams []Team, dryRun bool) (ImportResult, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.ImportTeams", attribute.Int("teams", len(teams)), attribute.Bool("dry_run", dryRun))
+	defer span.End()
+
+	if err := validateRoster(teams); err != nil {
+		return ImportResult{}, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	defer tx.Rollback()
+
+	diff, err := diffRoster(tx, teams)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	if diff.Empty() {
+		return ImportResult{Diff: diff}, nil
+	}
+
+	if err := applyImport(tx, diff); err != nil {
+		return ImportResult{}, err
+	}
+	diff.ReassignedReviews, err = s.reassignImported(tx, diff)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	if dryRun {
+		return ImportResult{Diff: diff}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return ImportResult{}, err
+	}
+
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"teams":             len(teams),
+		"created_teams":     len(diff.CreatedTeams),
+		"created_users":     len(diff.CreatedUsers),
+		"added_members":     len(diff.AddedMembers),
+		"removed_members":   len(diff.RemovedMembers),
+		"deactivated_users": len(diff.DeactivatedUsers),
+		"reassigned":        len(diff.ReassignedReviews),
+	}).Info("Teams imported")
+
+	return ImportResult{Diff: diff, Applied: true}, nil
+}
+
+func validateRoster(teams []Team) error {
+	if len(teams) == 0 {
+		return fmt.Errorf("%w: roster has no teams", ErrInvalidRoster)
+	}
+
+	seenTeams := make(map[string]bool)
+	seenUsers := make(map[string]TeamMember)
+	for _, team := range teams {
+		if team.TeamName == "" {
+			return fmt.Errorf("%w: team_name is required", ErrInvalidRoster)
+		}
+		if seenTeams[team.TeamName] {
+			return fmt.Errorf("%w: team %q is listed more than once", ErrInvalidRoster, team.TeamName)
+		}
+		seenTeams[team.TeamName] = true
+
+		inTeam := make(map[string]bool, len(team.Members))
+		for _, member := range team.Members {
+			if member.UserID == "" || member.Username == "" {
+				return fmt.Errorf("%w: team %q: user_id and username are required", ErrInvalidRoster, team.TeamName)
+			}
+			if inTeam[member.UserID] {
+				return fmt.Errorf("%w: user %q is listed in team %q more than once", ErrInvalidRoster, member.UserID, team.TeamName)
+			}
+			inTeam[member.UserID] = true
+
+			// пользователь может состоять в нескольких командах, но описан должен быть одинаково
+			if other, ok := seenUsers[member.UserID]; ok &&
+				(other.Username != member.Username || other.IsActive != member.IsActive) {
+				return fmt.Errorf("%w: user %q has conflicting username or is_active across teams", ErrInvalidRoster, member.UserID)
+			}
+			seenUsers[member.UserID] = member
+		}
+	}
+
+	return nil
+}
+
+func diffRoster(tx team.Tx, teams []Team) (ImportDiff, error) {
+	teamNames := make([]string, 0, len(teams))
+	userIDs := make([]string, 0)
+	rosterUsers := make(map[string]ImportUser)
+	rosterMembers := make(map[string]map[string]bool, len(teams))
+	for _, team := range teams {
+		teamNames = append(teamNames, team.TeamName)
+		rosterMembers[team.TeamName] = make(map[string]bool, len(team.Members))
+		for _, member := range team.Members {
+			rosterMembers[team.TeamName][member.UserID] = true
+			if _, ok := rosterUsers[member.UserID]; ok {
+				continue
+			}
+			userIDs = append(userIDs, member.UserID)
+			rosterUsers[member.UserID] = ImportUser{
+				UserID:   member.UserID,
+				Username: member.Username,
+				IsActive: member.IsActive,
+			}
+		}
+	}
+
+	existingTeams, err := tx.LockTeams(teamNames)
+	if err != nil {
+		return ImportDiff{}, err
+	}
+	existingTeamSet := make(map[string]bool, len(existingTeams))
+	for _, teamName := range existingTeams {
+		existingTeamSet[teamName] = true
+	}
+
+	memberships, err := tx.GetMemberships(userIDs, teamNames)
+	if err != nil {
+		return ImportDiff{}, err
+	}
+
+	// текущее состояние: пользователи и все их команды (не только из состава)
+	current := make(map[string]ImportUser)
+	currentTeams := make(map[string]map[string]bool)
+	var currentOrder []string
+	for _, m := range memberships {
+		if _, ok := current[m.UserID]; !ok {
+			current[m.UserID] = ImportUser{
+				UserID:   m.UserID,
+				Username: m.Username,
+				IsActive: m.IsActive,
+			}
+			currentTeams[m.UserID] = make(map[string]bool)
+			currentOrder = append(currentOrder, m.UserID)
+		}
+		if m.TeamName != "" {
+			currentTeams[m.UserID][m.TeamName] = true
+		}
+	}
+
+	var diff ImportDiff
+	for _, teamName := range teamNames {
+		if !existingTeamSet[teamName] {
+			diff.CreatedTeams = append(diff.CreatedTeams, teamName)
+		}
+	}
+
+	for _, userID := range userIDs {
+		target := rosterUsers[userID]
+		existing, ok := current[userID]
+		switch {
+		case !ok:
+			diff.CreatedUsers = append(diff.CreatedUsers, target)
+		case existing.Username != target.Username || existing.IsActive != target.IsActive:
+			diff.UpdatedUsers = append(diff.UpdatedUsers, target)
+		}
+	}
+
+	for _, team := range teams {
+		for _, member := range team.Members {
+			if !currentTeams[member.UserID][team.TeamName] {
+				diff.AddedMembers = append(diff.AddedMembers, Membership{
+					TeamName: team.TeamName,
+					UserID:   member.UserID,
+					Username: member.Username,
+				})
+			}
+		}
+	}
+
+	for _, userID := range currentOrder {
+		u := current[userID]
+		remaining := 0
+		for teamName := range currentTeams[userID] {
+			if members, ok := rosterMembers[teamName]; ok && !members[userID] {
+				diff.RemovedMembers = append(diff.RemovedMembers, Membership{
+					TeamName: teamName,
+					UserID:   userID,
+					Username: u.Username,
+				})
+				continue
+			}
+			remaining++
+		}
+
+		if _, inRoster := rosterUsers[userID]; !inRoster && remaining == 0 && u.IsActive {
+			u.IsActive = false
+			diff.DeactivatedUsers = append(diff.DeactivatedUsers, u)
+		}
+	}
+	sort.Slice(diff.RemovedMembers, func(i, j int) bool {
+		if diff.RemovedMembers[i].TeamName != diff.RemovedMembers[j].TeamName {
+			return diff.RemovedMembers[i].TeamName < diff.RemovedMembers[j].TeamName
+		}
+		return diff.RemovedMembers[i].UserID < diff.RemovedMembers[j].UserID
+	})
+	sort.Slice(diff.DeactivatedUsers, func(i, j int) bool {
+		return diff.DeactivatedUsers[i].UserID < diff.DeactivatedUsers[j].UserID
+	})
+
+	return diff, nil
+}
+
+func applyImport(tx team.Tx, diff ImportDiff) error {
+	for _, teamName := range diff.CreatedTeams {
+		// несуществующую команду не заблокировать: ее мог успеть создать параллельный запрос
+		if err := tx.CreateTeam(teamName); err != nil {
+			if errors.Is(err, store.ErrDuplicateKey) {
+				return fmt.Errorf("%w: team %q was created concurrently", ErrTeamExists, teamName)
+			}
+			return err
+		}
+	}
+
+	for _, u := range diff.CreatedUsers {
+		if err := createUser(tx, u.UserID, u.Username, u.IsActive); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range diff.UpdatedUsers {
+		if err := tx.UpdateUser(u.UserID, u.Username, u.IsActive); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range diff.AddedMembers {
+		if err := tx.AddMember(m.TeamName, m.UserID, RoleMember); err != nil {
+			return err
+		}
+	}
+
+	// RemovedMembers отсортированы по команде, снимаем членства одним запросом на команду
+	for i := 0; i < len(diff.RemovedMembers); {
+		j := i
+		var userIDs []string
+		for ; j < len(diff.RemovedMembers) && diff.RemovedMembers[j].TeamName == diff.RemovedMembers[i].TeamName; j++ {
+			userIDs = append(userIDs, diff.RemovedMembers[j].UserID)
+		}
+		if err := tx.RemoveMembers(diff.RemovedMembers[i].TeamName, userIDs); err != nil {
+			return err
+		}
+		i = j
+	}
+
+	for _, u := range diff.DeactivatedUsers {
+		if err := tx.UpdateUser(u.UserID, u.Username, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reassignImported переназначает открытые ревью после применения импорта: снятых участников -
+// в PR команд, из которых их исключили, ставших неактивными - в PR всех команд
+func (s *Service) reassignImported(tx team.Tx, diff ImportDiff) ([]ReassignedReview, error) {
+	leaving := make(map[string][]string)
+	var teamNames []string
+	addLeaving := func(teamName, userID string) {
+		if _, ok := leaving[teamName]; !ok {
+			teamNames = append(teamNames, teamName)
+		}
+		for _, id := range leaving[teamName] {
+			if id == userID {
+				return
+			}
+		}
+		leaving[teamName] = append(leaving[teamName], userID)
+	}
+
+	for _, m := range diff.RemovedMembers {
+		addLeaving(m.TeamName, m.UserID)
+	}
+
+	inactive := make(map[string]bool)
+	var inactiveIDs []string
+	for _, users := range [][]ImportUser{diff.UpdatedUsers, diff.DeactivatedUsers} {
+		for _, u := range users {
+			if !u.IsActive && !inactive[u.UserID] {
+				inactive[u.UserID] = true
+				inactiveIDs = append(inactiveIDs, u.UserID)
+			}
+		}
+	}
+	if len(inactiveIDs) > 0 {
+		reviews, err := tx.GetOpenReviews(inactiveIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range reviews {
+			if review.TeamName == "" {
+				continue
+			}
+			for _, reviewerID := range review.AssignedReviewers {
+				if inactive[reviewerID] {
+					addLeaving(review.TeamName, reviewerID)
+				}
+			}
+		}
+	}
+
+	sort.Strings(teamNames)
+	reassigned := make([]ReassignedReview, 0)
+	for _, teamName := range teamNames {
+		changes, err := s.reassignReviews(tx, teamName, leaving[teamName])
+		if err != nil {
+			return nil, err
+		}
+		reassigned = append(reassigned, changes...)
+	}
+
+	return reassigned, nil
+}
@@ -0,0 +1,292 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_ImportTeams(t *testing.T) {
+	backendRoster := []Team{
+		{TeamName: "backend", Members: []TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bobby", IsActive: true},
+			{UserID: "u3", Username: "Carol", IsActive: true},
+		}},
+		{TeamName: "platform", Members: []TeamMember{
+			{UserID: "u5", Username: "Eve", IsActive: false},
+			{UserID: "u3", Username: "Carol", IsActive: true},
+		}},
+	}
+
+	// u1 без изменений, u2 переименован, u3 добавляется в backend и platform (оставаясь во frontend),
+	// u4 удален из единственной команды, u6 удален из backend, но остается в devops, u5 новый
+	setupState := func(m *mockRepo, tx *mockTx) {
+		m.On("BeginTx", mock.Anything).Return(tx, nil)
+		tx.On("LockTeams", []string{"backend", "platform"}).Return([]string{"backend"}, nil)
+		tx.On("GetMemberships", []string{"u1", "u2", "u3", "u5"}, []string{"backend", "platform"}).Return([]team.User{
+			{UserID: "u1", Username: "Alice", TeamName: "backend", Role: RoleMember, IsActive: true},
+			{UserID: "u2", Username: "Bob", TeamName: "backend", Role: RoleMember, IsActive: true},
+			{UserID: "u3", Username: "Carol", TeamName: "frontend", Role: RoleMember, IsActive: true},
+			{UserID: "u4", Username: "Dave", TeamName: "backend", Role: RoleMember, IsActive: true},
+			{UserID: "u6", Username: "Frank", TeamName: "backend", Role: RoleMember, IsActive: true},
+			{UserID: "u6", Username: "Frank", TeamName: "devops", Role: RoleLead, IsActive: true},
+		}, nil)
+	}
+
+	// u4 снят из backend и деактивирован: его ревью в pr-1 переходит единственному свободному кандидату u3
+	setupApply := func(tx *mockTx) {
+		tx.On("CreateTeam", "platform").Return(nil)
+		tx.On("CreateUser", "u5", "Eve", false).Return(nil)
+		tx.On("UpdateUser", "u2", "Bobby", true).Return(nil)
+		tx.On("AddMember", "backend", "u3", RoleMember).Return(nil)
+		tx.On("AddMember", "platform", "u5", RoleMember).Return(nil)
+		tx.On("AddMember", "platform", "u3", RoleMember).Return(nil)
+		tx.On("RemoveMembers", "backend", []string{"u4", "u6"}).Return(nil)
+		tx.On("UpdateUser", "u4", "Dave", false).Return(nil)
+
+		openReviews := []team.OpenReview{
+			{PullRequestID: "pr-1", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u4", "u2"}},
+		}
+		tx.On("GetOpenReviews", []string{"u4"}).Return(openReviews, nil)
+		tx.On("GetOpenReviews", []string{"u4", "u6"}).Return(openReviews, nil)
+		tx.On("GetTeamMembers", "backend").Return([]team.User{
+			{UserID: "u1", Username: "Alice", Role: RoleMember, IsActive: true},
+			{UserID: "u2", Username: "Bobby", Role: RoleMember, IsActive: true},
+			{UserID: "u3", Username: "Carol", Role: RoleMember, IsActive: true},
+		}, nil)
+		tx.On("UpdateReviewers", "pr-1", []string{"u3", "u2"}).Return(nil)
+	}
+
+	expectedDiff := ImportDiff{
+		CreatedTeams: []string{"platform"},
+		CreatedUsers: []ImportUser{
+			{UserID: "u5", Username: "Eve", IsActive: false},
+		},
+		UpdatedUsers: []ImportUser{
+			{UserID: "u2", Username: "Bobby", IsActive: true},
+		},
+		AddedMembers: []Membership{
+			{TeamName: "backend", UserID: "u3", Username: "Carol"},
+			{TeamName: "platform", UserID: "u5", Username: "Eve"},
+			{TeamName: "platform", UserID: "u3", Username: "Carol"},
+		},
+		RemovedMembers: []Membership{
+			{TeamName: "backend", UserID: "u4", Username: "Dave"},
+			{TeamName: "backend", UserID: "u6", Username: "Frank"},
+		},
+		DeactivatedUsers: []ImportUser{
+			{UserID: "u4", Username: "Dave", IsActive: false},
+		},
+		ReassignedReviews: []ReassignedReview{
+			{PullRequestID: "pr-1", OldReviewerID: "u4", NewReviewerID: "u3"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		teams          []Team
+		dryRun         bool
+		setupMock      func(*mockRepo, *mockTx)
+		expectedError  error
+		expectedResult ImportResult
+	}{
+		{
+			name:   "apply diff in one transaction",
+			teams:  backendRoster,
+			dryRun: false,
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				setupState(m, tx)
+				setupApply(tx)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError:  nil,
+			expectedResult: ImportResult{Diff: expectedDiff, Applied: true},
+		},
+		{
+			name:   "dry run rolls back transaction",
+			teams:  backendRoster,
+			dryRun: true,
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				setupState(m, tx)
+				setupApply(tx)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError:  nil,
+			expectedResult: ImportResult{Diff: expectedDiff, Applied: false},
+		},
+		{
+			name: "removed member is cleared from open reviews",
+			teams: []Team{
+				{TeamName: "backend", Members: []TeamMember{
+					{UserID: "u1", Username: "Alice", IsActive: true},
+				}},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeams", []string{"backend"}).Return([]string{"backend"}, nil)
+				tx.On("GetMemberships", []string{"u1"}, []string{"backend"}).Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", Role: RoleMember, IsActive: true},
+					{UserID: "u2", Username: "Bob", TeamName: "backend", Role: RoleMember, IsActive: true},
+					{UserID: "u2", Username: "Bob", TeamName: "frontend", Role: RoleMember, IsActive: true},
+				}, nil)
+				tx.On("RemoveMembers", "backend", []string{"u2"}).Return(nil)
+				tx.On("GetOpenReviews", []string{"u2"}).Return([]team.OpenReview{
+					{PullRequestID: "pr-7", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2"}},
+				}, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", Role: RoleMember, IsActive: true},
+				}, nil)
+				tx.On("UpdateReviewers", "pr-7", []string{}).Return(nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			expectedResult: ImportResult{
+				Diff: ImportDiff{
+					RemovedMembers: []Membership{
+						{TeamName: "backend", UserID: "u2", Username: "Bob"},
+					},
+					ReassignedReviews: []ReassignedReview{
+						{PullRequestID: "pr-7", OldReviewerID: "u2"},
+					},
+				},
+				Applied: true,
+			},
+		},
+		{
+			name: "nothing to change",
+			teams: []Team{
+				{TeamName: "backend", Members: []TeamMember{
+					{UserID: "u1", Username: "Alice", IsActive: true},
+				}},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeams", []string{"backend"}).Return([]string{"backend"}, nil)
+				tx.On("GetMemberships", []string{"u1"}, []string{"backend"}).Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", Role: RoleMember, IsActive: true},
+					{UserID: "u1", Username: "Alice", TeamName: "frontend", Role: RoleMember, IsActive: true},
+				}, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError:  nil,
+			expectedResult: ImportResult{},
+		},
+		{
+			name: "user described differently in two teams",
+			teams: []Team{
+				{TeamName: "backend", Members: []TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+				{TeamName: "frontend", Members: []TeamMember{{UserID: "u1", Username: "Alice", IsActive: false}}},
+			},
+			setupMock:     func(m *mockRepo, tx *mockTx) {},
+			expectedError: ErrInvalidRoster,
+		},
+		{
+			name: "user listed twice in one team",
+			teams: []Team{
+				{TeamName: "backend", Members: []TeamMember{
+					{UserID: "u1", Username: "Alice", IsActive: true},
+					{UserID: "u1", Username: "Alice", IsActive: true},
+				}},
+			},
+			setupMock:     func(m *mockRepo, tx *mockTx) {},
+			expectedError: ErrInvalidRoster,
+		},
+		{
+			name: "duplicate team",
+			teams: []Team{
+				{TeamName: "backend"},
+				{TeamName: "backend"},
+			},
+			setupMock:     func(m *mockRepo, tx *mockTx) {},
+			expectedError: ErrInvalidRoster,
+		},
+		{
+			name: "missing username",
+			teams: []Team{
+				{TeamName: "backend", Members: []TeamMember{{UserID: "u1", IsActive: true}}},
+			},
+			setupMock:     func(m *mockRepo, tx *mockTx) {},
+			expectedError: ErrInvalidRoster,
+		},
+		{
+			name:          "empty roster",
+			teams:         nil,
+			setupMock:     func(m *mockRepo, tx *mockTx) {},
+			expectedError: ErrInvalidRoster,
+		},
+		{
+			name:   "team created concurrently",
+			teams:  backendRoster,
+			dryRun: false,
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				setupState(m, tx)
+				tx.On("CreateTeam", "platform").Return(store.ErrDuplicateKey)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrTeamExists,
+		},
+		{
+			name:   "error applying changes rolls back",
+			teams:  backendRoster,
+			dryRun: false,
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				setupState(m, tx)
+				tx.On("CreateTeam", "platform").Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name: "error loading users",
+			teams: []Team{
+				{TeamName: "backend", Members: []TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeams", []string{"backend"}).Return([]string{"backend"}, nil)
+				tx.On("GetMemberships", []string{"u1"}, []string{"backend"}).Return(nil, errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			mockTx := new(mockTx)
+			tt.setupMock(mockRepo, mockTx)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			ctx := context.Background()
+			result, err := service.ImportTeams(ctx, tt.teams, tt.dryRun)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrInvalidRoster) || errors.Is(tt.expectedError, ErrTeamExists) {
+					assert.ErrorIs(t, err, tt.expectedError)
+				} else {
+					assert.Contains(t, err.Error(), tt.expectedError.Error())
+				}
+				assert.Equal(t, ImportResult{}, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 100
+)
+
+var (
+	ErrInvalidPagination = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid pagination")
+)
+
+// ListTeams возвращает страницу команд с именем, начинающимся с params.Prefix,
+// и общее количество таких команд
+func (s *Service) ListTeams(ctx context.Context, params ListTeamsParams) (TeamList, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.ListTeams")
+	defer span.End()
+
+	if params.Limit == 0 {
+		params.Limit = DefaultListLimit
+	}
+	if params.Limit < 0 || params.Limit > MaxListLimit {
+		return TeamList{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidPagination, MaxListLimit)
+	}
+	if params.Offset < 0 {
+		return TeamList{}, fmt.Errorf("%w: offset must not be negative", ErrInvalidPagination)
+	}
+
+	repoTeams, err := s.repo.ListTeams(ctx, team.TeamListFilter{
+		Prefix: params.Prefix,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		return TeamList{}, err
+	}
+
+	total, err := s.repo.CountTeams(ctx, params.Prefix)
+	if err != nil {
+		return TeamList{}, err
+	}
+
+	teams := make([]TeamSummary, len(repoTeams))
+	for i, t := range repoTeams {
+		teams[i] = TeamSummary{
+			TeamName:           t.TeamName,
+			MembersCount:       t.MembersCount,
+			ActiveMembersCount: t.ActiveMembersCount,
+			OpenPRsCount:       t.OpenPRsCount,
+		}
+	}
+
+	return TeamList{
+		Teams:  teams,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}, nil
+}
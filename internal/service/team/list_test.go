@@ -0,0 +1,119 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_ListTeams(t *testing.T) {
+	tests := []struct {
+		name           string
+		params         ListTeamsParams
+		setupMock      func(*mockRepo)
+		expectedError  error
+		validateResult func(*testing.T, TeamList)
+	}{
+		{
+			name:   "successful list with default limit",
+			params: ListTeamsParams{},
+			setupMock: func(m *mockRepo) {
+				m.On("ListTeams", mock.Anything, team.TeamListFilter{Limit: DefaultListLimit}).Return([]team.TeamSummary{
+					{TeamName: "backend", MembersCount: 3, ActiveMembersCount: 2, OpenPRsCount: 4},
+					{TeamName: "frontend", MembersCount: 1, ActiveMembersCount: 1},
+				}, nil)
+				m.On("CountTeams", mock.Anything, "").Return(2, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, list TeamList) {
+				assert.Len(t, list.Teams, 2)
+				assert.Equal(t, "backend", list.Teams[0].TeamName)
+				assert.Equal(t, 3, list.Teams[0].MembersCount)
+				assert.Equal(t, 2, list.Teams[0].ActiveMembersCount)
+				assert.Equal(t, 4, list.Teams[0].OpenPRsCount)
+				assert.Equal(t, "frontend", list.Teams[1].TeamName)
+				assert.Equal(t, 2, list.Total)
+				assert.Equal(t, DefaultListLimit, list.Limit)
+				assert.Equal(t, 0, list.Offset)
+			},
+		},
+		{
+			name:   "prefix and page are passed to repository",
+			params: ListTeamsParams{Prefix: "back", Limit: 10, Offset: 10},
+			setupMock: func(m *mockRepo) {
+				m.On("ListTeams", mock.Anything, team.TeamListFilter{Prefix: "back", Limit: 10, Offset: 10}).Return(nil, nil)
+				m.On("CountTeams", mock.Anything, "back").Return(3, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, list TeamList) {
+				assert.Empty(t, list.Teams)
+				assert.Equal(t, 3, list.Total)
+				assert.Equal(t, 10, list.Limit)
+				assert.Equal(t, 10, list.Offset)
+			},
+		},
+		{
+			name:           "limit too large",
+			params:         ListTeamsParams{Limit: MaxListLimit + 1},
+			setupMock:      func(m *mockRepo) {},
+			expectedError:  ErrInvalidPagination,
+			validateResult: nil,
+		},
+		{
+			name:           "negative offset",
+			params:         ListTeamsParams{Offset: -1},
+			setupMock:      func(m *mockRepo) {},
+			expectedError:  ErrInvalidPagination,
+			validateResult: nil,
+		},
+		{
+			name:   "database error",
+			params: ListTeamsParams{},
+			setupMock: func(m *mockRepo) {
+				m.On("ListTeams", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedError:  errors.New("database error"),
+			validateResult: nil,
+		},
+		{
+			name:   "count error",
+			params: ListTeamsParams{},
+			setupMock: func(m *mockRepo) {
+				m.On("ListTeams", mock.Anything, mock.Anything).Return([]team.TeamSummary{}, nil)
+				m.On("CountTeams", mock.Anything, "").Return(0, errors.New("count error"))
+			},
+			expectedError:  errors.New("count error"),
+			validateResult: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.ListTeams(context.Background(), tt.params)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Empty(t, result.Teams)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrNotTeamMember = apperr.New(apperr.KindNotFound, "NOT_FOUND", "user is not a team member")
+)
+
+// ReassignedReview замена ревьювера в открытом PR; NewReviewerID пуст, если замены не нашлось и ревьювер снят
+type ReassignedReview struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+}
+
+type TeamUpdateResult struct {
+	Team              Team
+	ReassignedReviews []ReassignedReview
+}
+
+// AddMembers добавляет участников в существующую команду: новые пользователи создаются,
+// у существующих обновляются имя и активность, членство в других командах сохраняется.
+// Для уже состоящих в команде участников обновляется роль.
+func (s *Service) AddMembers(ctx context.Context, teamName string, members []TeamMember) (TeamUpdateResult, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.AddMembers", attribute.String("team_name", teamName))
+	defer span.End()
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+	defer tx.Rollback()
+
+	exists, err := tx.LockTeam(teamName)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+	if !exists {
+		return TeamUpdateResult{}, ErrTeamNotFound
+	}
+
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	existingUsers, err := tx.GetUsers(userIDs)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+	existing := make(map[string]bool, len(existingUsers))
+	for _, u := range existingUsers {
+		existing[u.UserID] = true
+	}
+
+	for _, member := range members {
+		if existing[member.UserID] {
+			err = tx.UpdateUser(member.UserID, member.Username, member.IsActive)
+		} else {
+			err = createUser(tx, member.UserID, member.Username, member.IsActive)
+		}
+		if err != nil {
+			return TeamUpdateResult{}, err
+		}
+
+		if err := tx.AddMember(teamName, member.UserID, memberRole(member.Role)); err != nil {
+			return TeamUpdateResult{}, err
+		}
+	}
+
+	return s.commitTeamUpdate(tx, teamName, make([]ReassignedReview, 0))
+}
+
+// RemoveMembers исключает пользователей из команды (в других командах они остаются)
+// и переназначает их открытые ревью в PR этой команды на оставшихся активных участников.
+func (s *Service) RemoveMembers(ctx context.Context, teamName string, userIDs []string) (TeamUpdateResult, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.RemoveMembers", attribute.String("team_name", teamName))
+	defer span.End()
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+	defer tx.Rollback()
+
+	exists, err := tx.LockTeam(teamName)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+	if !exists {
+		return TeamUpdateResult{}, ErrTeamNotFound
+	}
+
+	members, err := tx.GetTeamMembers(teamName)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.UserID] = true
+	}
+	for _, userID := range userIDs {
+		if !isMember[userID] {
+			return TeamUpdateResult{}, fmt.Errorf("%w: user %q is not a member of team %q", ErrNotTeamMember, userID, teamName)
+		}
+	}
+
+	if err := tx.RemoveMembers(teamName, userIDs); err != nil {
+		return TeamUpdateResult{}, err
+	}
+
+	reassigned, err := s.reassignReviews(tx, teamName, userIDs)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+
+	return s.commitTeamUpdate(tx, teamName, reassigned)
+}
+
+// reassignReviews заменяет leavingIDs в открытых PR команды teamName на случайных
+// активных участников команды (не автора и не уже назначенных). Если кандидатов нет,
+// ревьювер просто снимается. Вызывается после изменения состава команды.
+func (s *Service) reassignReviews(tx team.Tx, teamName string, leavingIDs []string) ([]ReassignedReview, error) {
+	reviews, err := tx.GetOpenReviews(leavingIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := tx.GetTeamMembers(teamName)
+	if err != nil {
+		return nil, err
+	}
+	var activeIDs []string
+	for _, m := range members {
+		if m.IsActive {
+			activeIDs = append(activeIDs, m.UserID)
+		}
+	}
+
+	leaving := make(map[string]bool, len(leavingIDs))
+	for _, userID := range leavingIDs {
+		leaving[userID] = true
+	}
+
+	reassigned := make([]ReassignedReview, 0)
+	for _, review := range reviews {
+		if review.TeamName != teamName {
+			continue
+		}
+
+		excluded := map[string]bool{review.AuthorID: true}
+		for _, reviewerID := range review.AssignedReviewers {
+			excluded[reviewerID] = true
+		}
+
+		reviewers := make([]string, 0, len(review.AssignedReviewers))
+		for _, reviewerID := range review.AssignedReviewers {
+			if !leaving[reviewerID] {
+				reviewers = append(reviewers, reviewerID)
+				continue
+			}
+
+			var candidates []string
+			for _, userID := range activeIDs {
+				if !excluded[userID] {
+					candidates = append(candidates, userID)
+				}
+			}
+
+			change := ReassignedReview{
+				PullRequestID: review.PullRequestID,
+				OldReviewerID: reviewerID,
+			}
+			if len(candidates) > 0 {
+				change.NewReviewerID = candidates[rand.Intn(len(candidates))]
+				excluded[change.NewReviewerID] = true
+				reviewers = append(reviewers, change.NewReviewerID)
+			}
+			reassigned = append(reassigned, change)
+		}
+
+		if err := tx.UpdateReviewers(review.PullRequestID, reviewers); err != nil {
+			return nil, err
+		}
+	}
+
+	return reassigned, nil
+}
+
+// createUser создает пользователя в транзакции; вставку, проигравшую гонку с параллельным созданием, переводит в ErrUserExists
+func createUser(tx team.Tx, userID, username string, isActive bool) error {
+	if err := tx.CreateUser(userID, username, isActive); err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return fmt.Errorf("%w: user %q was created concurrently", ErrUserExists, userID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) commitTeamUpdate(tx team.Tx, teamName string, reassigned []ReassignedReview) (TeamUpdateResult, error) {
+	members, err := tx.GetTeamMembers(teamName)
+	if err != nil {
+		return TeamUpdateResult{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return TeamUpdateResult{}, err
+	}
+
+	return TeamUpdateResult{
+		Team:              teamFromUsers(teamName, members),
+		ReassignedReviews: reassigned,
+	}, nil
+}
+
+func teamFromUsers(teamName string, users []team.User) Team {
+	members := make([]TeamMember, len(users))
+	for i, u := range users {
+		members[i] = TeamMember{
+			UserID:   u.UserID,
+			Username: u.Username,
+			Role:     u.Role,
+			IsActive: u.IsActive,
+		}
+	}
+	return Team{
+		TeamName: teamName,
+		Members:  members,
+	}
+}
@@ -0,0 +1,271 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_AddMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamName       string
+		members        []TeamMember
+		setupMock      func(*mockRepo, *mockTx)
+		expectedError  error
+		validateResult func(*testing.T, TeamUpdateResult)
+	}{
+		{
+			name:     "create new user and add member of another team",
+			teamName: "backend",
+			members: []TeamMember{
+				{UserID: "u5", Username: "Eve", IsActive: true},
+				{UserID: "u3", Username: "Carol", Role: RoleLead, IsActive: true},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetUsers", []string{"u5", "u3"}).Return([]team.User{
+					{UserID: "u3", Username: "Carol", IsActive: true},
+				}, nil)
+				tx.On("CreateUser", "u5", "Eve", true).Return(nil)
+				tx.On("AddMember", "backend", "u5", RoleMember).Return(nil)
+				// u3 остается и во frontend, его ревью не переназначаются
+				tx.On("UpdateUser", "u3", "Carol", true).Return(nil)
+				tx.On("AddMember", "backend", "u3", RoleLead).Return(nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", Role: RoleMember, IsActive: true},
+					{UserID: "u3", Username: "Carol", TeamName: "backend", Role: RoleLead, IsActive: true},
+					{UserID: "u5", Username: "Eve", TeamName: "backend", Role: RoleMember, IsActive: true},
+				}, nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, result TeamUpdateResult) {
+				assert.Equal(t, "backend", result.Team.TeamName)
+				assert.Len(t, result.Team.Members, 3)
+				assert.Equal(t, RoleLead, result.Team.Members[1].Role)
+				assert.Empty(t, result.ReassignedReviews)
+			},
+		},
+		{
+			name:     "update existing member without reassignment",
+			teamName: "backend",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice Smith", IsActive: true},
+			},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetUsers", []string{"u1"}).Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+				}, nil)
+				tx.On("UpdateUser", "u1", "Alice Smith", true).Return(nil)
+				tx.On("AddMember", "backend", "u1", RoleMember).Return(nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice Smith", TeamName: "backend", IsActive: true},
+				}, nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, result TeamUpdateResult) {
+				assert.Equal(t, "Alice Smith", result.Team.Members[0].Username)
+				assert.Empty(t, result.ReassignedReviews)
+			},
+		},
+		{
+			name:     "team not found",
+			teamName: "nonexistent",
+			members:  []TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "nonexistent").Return(false, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:     "error creating user",
+			teamName: "backend",
+			members:  []TeamMember{{UserID: "u5", Username: "Eve", IsActive: true}},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetUsers", []string{"u5"}).Return([]team.User{}, nil)
+				tx.On("CreateUser", "u5", "Eve", true).Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			mockTx := new(mockTx)
+			tt.setupMock(mockRepo, mockTx)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			ctx := context.Background()
+			result, err := service.AddMembers(ctx, tt.teamName, tt.members)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrTeamNotFound) {
+					assert.ErrorIs(t, err, ErrTeamNotFound)
+				} else {
+					assert.Contains(t, err.Error(), tt.expectedError.Error())
+				}
+				assert.Equal(t, TeamUpdateResult{}, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RemoveMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamName       string
+		userIDs        []string
+		setupMock      func(*mockRepo, *mockTx)
+		expectedError  error
+		validateResult func(*testing.T, TeamUpdateResult)
+	}{
+		{
+			name:     "remove member and reassign open reviews",
+			teamName: "backend",
+			userIDs:  []string{"u2"},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+					{UserID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+				}, nil).Once()
+				tx.On("RemoveMembers", "backend", []string{"u2"}).Return(nil)
+				tx.On("GetOpenReviews", []string{"u2"}).Return([]team.OpenReview{
+					{PullRequestID: "pr-1", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2"}},
+					{PullRequestID: "pr-2", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2", "u3"}},
+					// PR другой команды не трогается: u2 остается в ней участником
+					{PullRequestID: "pr-3", AuthorID: "u7", TeamName: "devops", AssignedReviewers: []string{"u2"}},
+				}, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+					{UserID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+				}, nil)
+				// pr-1: единственный кандидат u3; pr-2: u3 уже назначен, ревьювер снимается
+				tx.On("UpdateReviewers", "pr-1", []string{"u3"}).Return(nil)
+				tx.On("UpdateReviewers", "pr-2", []string{"u3"}).Return(nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, result TeamUpdateResult) {
+				assert.Len(t, result.Team.Members, 2)
+				assert.Equal(t, []ReassignedReview{
+					{PullRequestID: "pr-1", OldReviewerID: "u2", NewReviewerID: "u3"},
+					{PullRequestID: "pr-2", OldReviewerID: "u2", NewReviewerID: ""},
+				}, result.ReassignedReviews)
+			},
+		},
+		{
+			name:     "user is not a member",
+			teamName: "backend",
+			userIDs:  []string{"u9"},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+				}, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrNotTeamMember,
+		},
+		{
+			name:     "team not found",
+			teamName: "nonexistent",
+			userIDs:  []string{"u1"},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "nonexistent").Return(false, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:     "error updating reviewers",
+			teamName: "backend",
+			userIDs:  []string{"u2"},
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+				}, nil).Once()
+				tx.On("RemoveMembers", "backend", []string{"u2"}).Return(nil)
+				tx.On("GetOpenReviews", []string{"u2"}).Return([]team.OpenReview{
+					{PullRequestID: "pr-1", AuthorID: "u1", TeamName: "backend", AssignedReviewers: []string{"u2"}},
+				}, nil)
+				tx.On("GetTeamMembers", "backend").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+				}, nil)
+				tx.On("UpdateReviewers", "pr-1", []string{}).Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			mockTx := new(mockTx)
+			tt.setupMock(mockRepo, mockTx)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			ctx := context.Background()
+			result, err := service.RemoveMembers(ctx, tt.teamName, tt.userIDs)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrTeamNotFound) || errors.Is(tt.expectedError, ErrNotTeamMember) {
+					assert.ErrorIs(t, err, tt.expectedError)
+				} else {
+					assert.Contains(t, err.Error(), tt.expectedError.Error())
+				}
+				assert.Equal(t, TeamUpdateResult{}, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+		})
+	}
+}
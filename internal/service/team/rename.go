@@ -0,0 +1,52 @@
+package team
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RenameTeam переименовывает команду; участники и их ревью не меняются
+func (s *Service) RenameTeam(ctx context.Context, teamName, newTeamName string) (Team, error) {
+	ctx, span := tracing.Start(ctx, "team.Service.RenameTeam", attribute.String("team_name", teamName), attribute.String("new_team_name", newTeamName))
+	defer span.End()
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return Team{}, err
+	}
+	defer tx.Rollback()
+
+	exists, err := tx.LockTeam(teamName)
+	if err != nil {
+		return Team{}, err
+	}
+	if !exists {
+		return Team{}, ErrTeamNotFound
+	}
+
+	exists, err = tx.LockTeam(newTeamName)
+	if err != nil {
+		return Team{}, err
+	}
+	if exists {
+		return Team{}, ErrTeamExists
+	}
+
+	if err := tx.RenameTeam(teamName, newTeamName); err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return Team{}, ErrTeamExists
+		}
+		return Team{}, err
+	}
+
+	result, err := s.commitTeamUpdate(tx, newTeamName, nil)
+	if err != nil {
+		return Team{}, err
+	}
+
+	return result.Team, nil
+}
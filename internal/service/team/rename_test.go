@@ -0,0 +1,113 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_RenameTeam(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamName       string
+		newTeamName    string
+		setupMock      func(*mockRepo, *mockTx)
+		expectedError  error
+		validateResult func(*testing.T, Team)
+	}{
+		{
+			name:        "successful rename",
+			teamName:    "backend",
+			newTeamName: "platform",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("LockTeam", "platform").Return(false, nil)
+				tx.On("RenameTeam", "backend", "platform").Return(nil)
+				tx.On("GetTeamMembers", "platform").Return([]team.User{
+					{UserID: "u1", Username: "Alice", TeamName: "platform", IsActive: true},
+				}, nil)
+				tx.On("Commit").Return(nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, result Team) {
+				assert.Equal(t, "platform", result.TeamName)
+				assert.Equal(t, []TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}, result.Members)
+			},
+		},
+		{
+			name:        "team not found",
+			teamName:    "nonexistent",
+			newTeamName: "platform",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "nonexistent").Return(false, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrTeamNotFound,
+		},
+		{
+			name:        "new name already taken",
+			teamName:    "backend",
+			newTeamName: "frontend",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("LockTeam", "frontend").Return(true, nil)
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: ErrTeamExists,
+		},
+		{
+			name:        "error renaming",
+			teamName:    "backend",
+			newTeamName: "platform",
+			setupMock: func(m *mockRepo, tx *mockTx) {
+				m.On("BeginTx", mock.Anything).Return(tx, nil)
+				tx.On("LockTeam", "backend").Return(true, nil)
+				tx.On("LockTeam", "platform").Return(false, nil)
+				tx.On("RenameTeam", "backend", "platform").Return(errors.New("database error"))
+				tx.On("Rollback").Return(nil)
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepo)
+			mockTx := new(mockTx)
+			tt.setupMock(mockRepo, mockTx)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			ctx := context.Background()
+			result, err := service.RenameTeam(ctx, tt.teamName, tt.newTeamName)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				if errors.Is(tt.expectedError, ErrTeamNotFound) || errors.Is(tt.expectedError, ErrTeamExists) {
+					assert.ErrorIs(t, err, tt.expectedError)
+				} else {
+					assert.Contains(t, err.Error(), tt.expectedError.Error())
+				}
+				assert.Equal(t, Team{}, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockTx.AssertExpectations(t)
+		})
+	}
+}
@@ -8,6 +8,11 @@ import (
 
 type Repo interface {
 	GetUser(ctx context.Context, userID string) (user.User, error)
+	ListUsers(ctx context.Context, filter user.UserListFilter) ([]user.User, error)
+	CountUsers(ctx context.Context, filter user.UserListFilter) (int, error)
 	UpdateUserIsActive(ctx context.Context, userID string, isActive bool) error
-	GetUserPullRequests(ctx context.Context, userID string) ([]user.PullRequestShort, error)
+	UpdateUsername(ctx context.Context, userID, username string) error
+	SetExpertise(ctx context.Context, userID string, tags []string) error
+	GetUserPullRequests(ctx context.Context, filter user.ReviewFilter) ([]user.PullRequestShort, error)
+	CountUserPullRequests(ctx context.Context, filter user.ReviewFilter) (int, error)
 }
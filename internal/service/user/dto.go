@@ -1,24 +1,73 @@
 package user
 
-import "github.com/aabbuukkaarr8/PRService/internal/repository/user"
+import (
+	"time"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+)
 
 type User struct {
+	UserID    string
+	Username  string
+	TeamName  string
+	TeamNames []string
+	Expertise []string
+	IsActive  bool
+}
+
+// ListUsersParams фильтр и страница списка пользователей; пустые поля не фильтруют,
+// нулевой Limit означает DefaultListLimit
+type ListUsersParams struct {
+	TeamName       string
+	IsActive       *bool
+	UsernamePrefix string
+	Limit          int
+	Offset         int
+}
+
+// UserList страница списка пользователей; Total - число пользователей под фильтром без учёта страницы
+type UserList struct {
+	Users  []User
+	Total  int
+	Limit  int
+	Offset int
+}
+
+// ReviewParams фильтр и страница очереди ревью; пустой Statuses означает только OPEN,
+// Order - OrderAsc (сначала старые, по умолчанию) или OrderDesc, нулевой Limit означает DefaultListLimit
+type ReviewParams struct {
 	UserID   string
-	Username string
-	TeamName string
-	IsActive bool
+	Statuses []string
+	Order    string
+	Limit    int
+	Offset   int
+}
+
+// ReviewList страница очереди ревью; Total - число PR под фильтром без учёта страницы
+type ReviewList struct {
+	PullRequests []PullRequestShort
+	Total        int
+	Limit        int
+	Offset       int
 }
 
+// PullRequestShort PR в очереди ревью; CoReviewers - остальные ревьюверы, Age - время с создания PR
 type PullRequestShort struct {
 	PullRequestID   string
 	PullRequestName string
 	AuthorID        string
+	AuthorUsername  string
 	Status          string
+	CoReviewers     []string
+	CreatedAt       *time.Time
+	Age             time.Duration
 }
 
 func (m *User) FillFromDB(dbu *user.User) {
 	m.UserID = dbu.UserID
 	m.Username = dbu.Username
 	m.TeamName = dbu.TeamName
+	m.TeamNames = dbu.TeamNames
+	m.Expertise = dbu.Expertise
 	m.IsActive = dbu.IsActive
 }
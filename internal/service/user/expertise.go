@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/store"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrInvalidTag = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid expertise tag")
+)
+
+// SetExpertise заменяет теги экспертизы пользователя. Теги приводятся к нижнему регистру,
+// повторы отбрасываются; пустой список очищает экспертизу
+func (s *Service) SetExpertise(ctx context.Context, userID string, tags []string) (User, error) {
+	ctx, span := tracing.Start(ctx, "user.Service.SetExpertise", attribute.String("user_id", userID))
+	defer span.End()
+
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return User{}, err
+	}
+
+	repoUser, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+
+	if err := s.repo.SetExpertise(ctx, userID, normalized); err != nil {
+		if errors.Is(err, store.ErrForeignKeyViolated) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+
+	repoUser.Expertise = normalized
+
+	user := User{}
+	user.FillFromDB(&repoUser)
+
+	return user, nil
+}
+
+// NormalizeTags приводит теги к нижнему регистру, убирает пробелы по краям и повторы
+// и сортирует; тег с пробелом внутри или пустой - ошибка ErrInvalidTag
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
@@ -0,0 +1,111 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_SetExpertise(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         string
+		tags           []string
+		setupMock      func(*mockRepoForUser)
+		expectedError  error
+		validateResult func(*testing.T, User)
+	}{
+		{
+			name:   "tags are normalized",
+			userID: "user-001",
+			tags:   []string{" Postgres", "go", "GO"},
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					Expertise: []string{"java"},
+					IsActive:  true,
+				}, nil)
+				m.On("SetExpertise", mock.Anything, "user-001", []string{"go", "postgres"}).Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, u User) {
+				assert.Equal(t, "user-001", u.UserID)
+				assert.Equal(t, []string{"go", "postgres"}, u.Expertise)
+			},
+		},
+		{
+			name:   "empty list clears expertise",
+			userID: "user-001",
+			tags:   nil,
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{UserID: "user-001", Expertise: []string{"go"}}, nil)
+				m.On("SetExpertise", mock.Anything, "user-001", []string{}).Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, u User) {
+				assert.Empty(t, u.Expertise)
+			},
+		},
+		{
+			name:           "invalid tag",
+			userID:         "user-001",
+			tags:           []string{"go", "data base"},
+			setupMock:      func(m *mockRepoForUser) {},
+			expectedError:  ErrInvalidTag,
+			validateResult: nil,
+		},
+		{
+			name:   "user not found",
+			userID: "user-999",
+			tags:   []string{"go"},
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-999").Return(user.User{}, sql.ErrNoRows)
+			},
+			expectedError:  ErrUserNotFound,
+			validateResult: nil,
+		},
+		{
+			name:   "error saving expertise",
+			userID: "user-001",
+			tags:   []string{"go"},
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{UserID: "user-001"}, nil)
+				m.On("SetExpertise", mock.Anything, "user-001", []string{"go"}).Return(errors.New("database error"))
+			},
+			expectedError:  errors.New("database error"),
+			validateResult: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepoForUser)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.SetExpertise(context.Background(), tt.userID, tt.tags)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Equal(t, User{}, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *Service) GetUser(ctx context.Context, userID string) (User, error) {
+	ctx, span := tracing.Start(ctx, "user.Service.GetUser", attribute.String("user_id", userID))
+	defer span.End()
+
+	repoUser, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+
+	user := User{}
+	user.FillFromDB(&repoUser)
+
+	return user, nil
+}
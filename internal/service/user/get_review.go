@@ -4,37 +4,106 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/api/models"
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
 	repoUsers "github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (s *Service) GetReview(ctx context.Context, userID string) ([]PullRequestShort, error) {
-	_, err := s.repo.GetUser(ctx, userID)
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+var (
+	ErrInvalidFilter = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid filter")
+)
+
+// GetReview возвращает страницу PR, где пользователь назначен ревьювером, упорядоченных по возрасту
+func (s *Service) GetReview(ctx context.Context, params ReviewParams) (ReviewList, error) {
+	ctx, span := tracing.Start(ctx, "user.Service.GetReview", attribute.String("user_id", params.UserID))
+	defer span.End()
+
+	if params.Limit == 0 {
+		params.Limit = DefaultListLimit
+	}
+	if params.Limit < 0 || params.Limit > MaxListLimit {
+		return ReviewList{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidPagination, MaxListLimit)
+	}
+	if params.Offset < 0 {
+		return ReviewList{}, fmt.Errorf("%w: offset must not be negative", ErrInvalidPagination)
+	}
+
+	filter := repoUsers.ReviewFilter{
+		UserID:   params.UserID,
+		Statuses: params.Statuses,
+		Limit:    params.Limit,
+		Offset:   params.Offset,
+	}
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []string{string(models.PullRequestStatusOPEN)}
+	}
+	for _, status := range filter.Statuses {
+		if status != string(models.PullRequestStatusOPEN) && status != string(models.PullRequestStatusMERGED) {
+			return ReviewList{}, fmt.Errorf("%w: status must be OPEN or MERGED", ErrInvalidFilter)
+		}
+	}
+	switch params.Order {
+	case "", OrderAsc:
+	case OrderDesc:
+		filter.Desc = true
+	default:
+		return ReviewList{}, fmt.Errorf("%w: order must be %s or %s", ErrInvalidFilter, OrderAsc, OrderDesc)
+	}
+
+	_, err := s.repo.GetUser(ctx, params.UserID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrUserNotFound
+			return ReviewList{}, ErrUserNotFound
 		}
-		return nil, err
+		return ReviewList{}, err
 	}
 
-	repoPRs, err := s.repo.GetUserPullRequests(ctx, userID)
+	repoPRs, err := s.repo.GetUserPullRequests(ctx, filter)
 	if err != nil {
-		return nil, err
+		return ReviewList{}, err
 	}
 
+	total, err := s.repo.CountUserPullRequests(ctx, filter)
+	if err != nil {
+		return ReviewList{}, err
+	}
+
+	now := time.Now()
 	servicePRs := make([]PullRequestShort, len(repoPRs))
 	for i, pr := range repoPRs {
-		servicePRs[i] = toServicePullRequestShort(pr)
+		servicePRs[i] = toServicePullRequestShort(pr, now)
 	}
 
-	return servicePRs, nil
+	return ReviewList{
+		PullRequests: servicePRs,
+		Total:        total,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+	}, nil
 }
 
-func toServicePullRequestShort(r repoUsers.PullRequestShort) PullRequestShort {
-	return PullRequestShort{
+func toServicePullRequestShort(r repoUsers.PullRequestShort, now time.Time) PullRequestShort {
+	pr := PullRequestShort{
 		PullRequestID:   r.PullRequestID,
 		PullRequestName: r.PullRequestName,
 		AuthorID:        r.AuthorID,
+		AuthorUsername:  r.AuthorUsername,
 		Status:          r.Status,
+		CoReviewers:     r.CoReviewers,
+	}
+	if r.CreatedAt != nil {
+		pr.CreatedAt = r.CreatedAt
+		pr.Age = now.Sub(*r.CreatedAt)
 	}
+	return pr
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
 	"github.com/stretchr/testify/assert"
@@ -23,121 +24,138 @@ func (m *mockRepoForUser) GetUser(ctx context.Context, userID string) (user.User
 	return args.Get(0).(user.User), args.Error(1)
 }
 
+func (m *mockRepoForUser) ListUsers(ctx context.Context, filter user.UserListFilter) ([]user.User, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]user.User), args.Error(1)
+}
+
+func (m *mockRepoForUser) CountUsers(ctx context.Context, filter user.UserListFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockRepoForUser) UpdateUsername(ctx context.Context, userID, username string) error {
+	args := m.Called(ctx, userID, username)
+	return args.Error(0)
+}
+
+func (m *mockRepoForUser) SetExpertise(ctx context.Context, userID string, tags []string) error {
+	args := m.Called(ctx, userID, tags)
+	return args.Error(0)
+}
+
 func (m *mockRepoForUser) UpdateUserIsActive(ctx context.Context, userID string, isActive bool) error {
 	args := m.Called(ctx, userID, isActive)
 	return args.Error(0)
 }
 
-func (m *mockRepoForUser) GetUserPullRequests(ctx context.Context, userID string) ([]user.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
+func (m *mockRepoForUser) GetUserPullRequests(ctx context.Context, filter user.ReviewFilter) ([]user.PullRequestShort, error) {
+	args := m.Called(ctx, filter)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]user.PullRequestShort), args.Error(1)
 }
 
+func (m *mockRepoForUser) CountUserPullRequests(ctx context.Context, filter user.ReviewFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
 func TestService_GetReview(t *testing.T) {
+	createdAt := time.Now().Add(-2 * time.Hour)
+	openFilter := user.ReviewFilter{UserID: "user-001", Statuses: []string{"OPEN"}, Limit: DefaultListLimit}
+	existingUser := user.User{UserID: "user-001", Username: "alice", TeamName: "backend", IsActive: true}
+
 	tests := []struct {
 		name           string
-		userID          string
+		params         ReviewParams
 		setupMock      func(*mockRepoForUser)
 		expectedError  error
-		validateResult func(*testing.T, []PullRequestShort)
+		validateResult func(*testing.T, ReviewList)
 	}{
 		{
-			name:  "successful get with multiple PRs",
-			userID: "user-001",
+			name:   "open PRs by default",
+			params: ReviewParams{UserID: "user-001"},
 			setupMock: func(m *mockRepoForUser) {
-				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
-				}, nil)
-				m.On("GetUserPullRequests", mock.Anything, "user-001").Return([]user.PullRequestShort{
-					{PullRequestID: "pr-001", PullRequestName: "Test PR 1", AuthorID: "user-002", Status: "OPEN"},
-					{PullRequestID: "pr-002", PullRequestName: "Test PR 2", AuthorID: "user-003", Status: "MERGED"},
+				m.On("GetUser", mock.Anything, "user-001").Return(existingUser, nil)
+				m.On("GetUserPullRequests", mock.Anything, openFilter).Return([]user.PullRequestShort{
+					{PullRequestID: "pr-001", PullRequestName: "Test PR 1", AuthorID: "user-002", AuthorUsername: "bob", Status: "OPEN", CoReviewers: []string{"user-003"}, CreatedAt: &createdAt},
+					{PullRequestID: "pr-002", PullRequestName: "Test PR 2", AuthorID: "user-003", Status: "OPEN", CoReviewers: []string{}},
 				}, nil)
+				m.On("CountUserPullRequests", mock.Anything, openFilter).Return(2, nil)
 			},
-			expectedError: nil,
-			validateResult: func(t *testing.T, prs []PullRequestShort) {
-				assert.Len(t, prs, 2)
-				assert.Equal(t, "pr-001", prs[0].PullRequestID)
-				assert.Equal(t, "Test PR 1", prs[0].PullRequestName)
-				assert.Equal(t, "OPEN", prs[0].Status)
-				assert.Equal(t, "pr-002", prs[1].PullRequestID)
-				assert.Equal(t, "MERGED", prs[1].Status)
+			validateResult: func(t *testing.T, result ReviewList) {
+				assert.Len(t, result.PullRequests, 2)
+				assert.Equal(t, 2, result.Total)
+				assert.Equal(t, DefaultListLimit, result.Limit)
+				assert.Equal(t, "bob", result.PullRequests[0].AuthorUsername)
+				assert.Equal(t, []string{"user-003"}, result.PullRequests[0].CoReviewers)
+				assert.GreaterOrEqual(t, result.PullRequests[0].Age, 2*time.Hour)
+				assert.Zero(t, result.PullRequests[1].Age)
 			},
 		},
 		{
-			name:  "successful get with single PR",
-			userID: "user-002",
+			name:   "all statuses, newest first",
+			params: ReviewParams{UserID: "user-001", Statuses: []string{"OPEN", "MERGED"}, Order: OrderDesc, Limit: 10, Offset: 20},
 			setupMock: func(m *mockRepoForUser) {
-				m.On("GetUser", mock.Anything, "user-002").Return(user.User{
-					UserID:   "user-002",
-					Username: "bob",
-					TeamName: "frontend",
-					IsActive: true,
-				}, nil)
-				m.On("GetUserPullRequests", mock.Anything, "user-002").Return([]user.PullRequestShort{
-					{PullRequestID: "pr-003", PullRequestName: "Test PR 3", AuthorID: "user-001", Status: "OPEN"},
-				}, nil)
+				filter := user.ReviewFilter{UserID: "user-001", Statuses: []string{"OPEN", "MERGED"}, Desc: true, Limit: 10, Offset: 20}
+				m.On("GetUser", mock.Anything, "user-001").Return(existingUser, nil)
+				m.On("GetUserPullRequests", mock.Anything, filter).Return(nil, nil)
+				m.On("CountUserPullRequests", mock.Anything, filter).Return(20, nil)
 			},
-			expectedError: nil,
-			validateResult: func(t *testing.T, prs []PullRequestShort) {
-				assert.Len(t, prs, 1)
-				assert.Equal(t, "pr-003", prs[0].PullRequestID)
+			validateResult: func(t *testing.T, result ReviewList) {
+				assert.NotNil(t, result.PullRequests)
+				assert.Empty(t, result.PullRequests)
+				assert.Equal(t, 20, result.Total)
+				assert.Equal(t, 20, result.Offset)
 			},
 		},
 		{
-			name:  "successful get with no PRs",
-			userID: "user-003",
-			setupMock: func(m *mockRepoForUser) {
-				m.On("GetUser", mock.Anything, "user-003").Return(user.User{
-					UserID:   "user-003",
-					Username: "charlie",
-					TeamName: "devops",
-					IsActive: true,
-				}, nil)
-				m.On("GetUserPullRequests", mock.Anything, "user-003").Return([]user.PullRequestShort{}, nil)
-			},
-			expectedError: nil,
-			validateResult: func(t *testing.T, prs []PullRequestShort) {
-				assert.Empty(t, prs)
-			},
+			name:          "invalid status",
+			params:        ReviewParams{UserID: "user-001", Statuses: []string{"CLOSED"}},
+			setupMock:     func(m *mockRepoForUser) {},
+			expectedError: ErrInvalidFilter,
+		},
+		{
+			name:          "invalid order",
+			params:        ReviewParams{UserID: "user-001", Order: "oldest"},
+			setupMock:     func(m *mockRepoForUser) {},
+			expectedError: ErrInvalidFilter,
 		},
 		{
-			name:  "user not found",
-			userID: "user-999",
+			name:          "invalid limit",
+			params:        ReviewParams{UserID: "user-001", Limit: MaxListLimit + 1},
+			setupMock:     func(m *mockRepoForUser) {},
+			expectedError: ErrInvalidPagination,
+		},
+		{
+			name:   "user not found",
+			params: ReviewParams{UserID: "user-999"},
 			setupMock: func(m *mockRepoForUser) {
 				m.On("GetUser", mock.Anything, "user-999").Return(user.User{}, sql.ErrNoRows)
 			},
 			expectedError: ErrUserNotFound,
-			validateResult: nil,
 		},
 		{
-			name:  "error getting user",
-			userID: "user-001",
+			name:   "error getting user",
+			params: ReviewParams{UserID: "user-001"},
 			setupMock: func(m *mockRepoForUser) {
 				m.On("GetUser", mock.Anything, "user-001").Return(user.User{}, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
-			validateResult: nil,
 		},
 		{
-			name:  "error getting user PRs",
-			userID: "user-001",
+			name:   "error getting user PRs",
+			params: ReviewParams{UserID: "user-001"},
 			setupMock: func(m *mockRepoForUser) {
-				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
-					UserID:   "user-001",
-					Username: "alice",
-					TeamName: "backend",
-					IsActive: true,
-				}, nil)
-				m.On("GetUserPullRequests", mock.Anything, "user-001").Return(nil, errors.New("database error"))
+				m.On("GetUser", mock.Anything, "user-001").Return(existingUser, nil)
+				m.On("GetUserPullRequests", mock.Anything, openFilter).Return(nil, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
-			validateResult: nil,
 		},
 	}
 
@@ -151,25 +169,18 @@ func TestService_GetReview(t *testing.T) {
 			}
 
 			ctx := context.Background()
-			result, err := service.GetReview(ctx, tt.userID)
+			result, err := service.GetReview(ctx, tt.params)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
-				if errors.Is(tt.expectedError, ErrUserNotFound) {
-					assert.ErrorIs(t, err, ErrUserNotFound)
-				} else {
-					assert.Contains(t, err.Error(), tt.expectedError.Error())
-				}
-				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result.PullRequests)
 			} else {
 				assert.NoError(t, err)
-				if tt.validateResult != nil {
-					tt.validateResult(t, result)
-				}
+				tt.validateResult(t, result)
 			}
 
 			mockRepo.AssertExpectations(t)
 		})
 	}
 }
-
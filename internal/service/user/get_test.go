@@ -0,0 +1,87 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         string
+		setupMock      func(*mockRepoForUser)
+		expectedError  error
+		validateResult func(*testing.T, User)
+	}{
+		{
+			name:   "successful get",
+			userID: "user-001",
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend", "devops"},
+					IsActive:  true,
+				}, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, u User) {
+				assert.Equal(t, "user-001", u.UserID)
+				assert.Equal(t, "alice", u.Username)
+				assert.Equal(t, []string{"backend", "devops"}, u.TeamNames)
+				assert.True(t, u.IsActive)
+			},
+		},
+		{
+			name:   "user not found",
+			userID: "user-999",
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-999").Return(user.User{}, sql.ErrNoRows)
+			},
+			expectedError:  ErrUserNotFound,
+			validateResult: nil,
+		},
+		{
+			name:   "database error",
+			userID: "user-001",
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{}, errors.New("database error"))
+			},
+			expectedError:  errors.New("database error"),
+			validateResult: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepoForUser)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.GetUser(context.Background(), tt.userID)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Equal(t, User{}, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
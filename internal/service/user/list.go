@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+)
+
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 100
+)
+
+var (
+	ErrInvalidPagination = apperr.New(apperr.KindInvalid, "INVALID_REQUEST", "invalid pagination")
+)
+
+// ListUsers возвращает страницу пользователей под фильтром и их общее количество
+func (s *Service) ListUsers(ctx context.Context, params ListUsersParams) (UserList, error) {
+	ctx, span := tracing.Start(ctx, "user.Service.ListUsers")
+	defer span.End()
+
+	if params.Limit == 0 {
+		params.Limit = DefaultListLimit
+	}
+	if params.Limit < 0 || params.Limit > MaxListLimit {
+		return UserList{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidPagination, MaxListLimit)
+	}
+	if params.Offset < 0 {
+		return UserList{}, fmt.Errorf("%w: offset must not be negative", ErrInvalidPagination)
+	}
+
+	filter := user.UserListFilter{
+		TeamName:       params.TeamName,
+		IsActive:       params.IsActive,
+		UsernamePrefix: params.UsernamePrefix,
+		Limit:          params.Limit,
+		Offset:         params.Offset,
+	}
+
+	repoUsers, err := s.repo.ListUsers(ctx, filter)
+	if err != nil {
+		return UserList{}, err
+	}
+
+	total, err := s.repo.CountUsers(ctx, filter)
+	if err != nil {
+		return UserList{}, err
+	}
+
+	users := make([]User, len(repoUsers))
+	for i := range repoUsers {
+		users[i].FillFromDB(&repoUsers[i])
+	}
+
+	return UserList{
+		Users:  users,
+		Total:  total,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_ListUsers(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name           string
+		params         ListUsersParams
+		setupMock      func(*mockRepoForUser)
+		expectedError  error
+		validateResult func(*testing.T, UserList)
+	}{
+		{
+			name:   "successful list with default limit",
+			params: ListUsersParams{},
+			setupMock: func(m *mockRepoForUser) {
+				filter := user.UserListFilter{Limit: DefaultListLimit}
+				m.On("ListUsers", mock.Anything, filter).Return([]user.User{
+					{UserID: "user-001", Username: "alice", TeamName: "backend", TeamNames: []string{"backend"}, IsActive: true},
+					{UserID: "user-002", Username: "bob", IsActive: true},
+				}, nil)
+				m.On("CountUsers", mock.Anything, filter).Return(2, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, list UserList) {
+				assert.Len(t, list.Users, 2)
+				assert.Equal(t, "alice", list.Users[0].Username)
+				assert.Equal(t, []string{"backend"}, list.Users[0].TeamNames)
+				assert.Equal(t, "bob", list.Users[1].Username)
+				assert.Equal(t, 2, list.Total)
+				assert.Equal(t, DefaultListLimit, list.Limit)
+			},
+		},
+		{
+			name:   "filters are passed to repository",
+			params: ListUsersParams{TeamName: "backend", IsActive: &inactive, UsernamePrefix: "al", Limit: 5, Offset: 5},
+			setupMock: func(m *mockRepoForUser) {
+				filter := user.UserListFilter{TeamName: "backend", IsActive: &inactive, UsernamePrefix: "al", Limit: 5, Offset: 5}
+				m.On("ListUsers", mock.Anything, filter).Return(nil, nil)
+				m.On("CountUsers", mock.Anything, filter).Return(5, nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, list UserList) {
+				assert.Empty(t, list.Users)
+				assert.Equal(t, 5, list.Total)
+				assert.Equal(t, 5, list.Offset)
+			},
+		},
+		{
+			name:           "limit too large",
+			params:         ListUsersParams{Limit: MaxListLimit + 1},
+			setupMock:      func(m *mockRepoForUser) {},
+			expectedError:  ErrInvalidPagination,
+			validateResult: nil,
+		},
+		{
+			name:           "negative offset",
+			params:         ListUsersParams{Offset: -5},
+			setupMock:      func(m *mockRepoForUser) {},
+			expectedError:  ErrInvalidPagination,
+			validateResult: nil,
+		},
+		{
+			name:   "database error",
+			params: ListUsersParams{},
+			setupMock: func(m *mockRepoForUser) {
+				m.On("ListUsers", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedError:  errors.New("database error"),
+			validateResult: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepoForUser)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.ListUsers(context.Background(), tt.params)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Empty(t, result.Users)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
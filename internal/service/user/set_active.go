@@ -4,13 +4,21 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/apperr"
+	"github.com/aabbuukkaarr8/PRService/internal/logging"
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
-	ErrUserNotFound = errors.New("NOT_FOUND")
+	ErrUserNotFound = apperr.New(apperr.KindNotFound, "NOT_FOUND", "user not found")
 )
 
 func (s *Service) SetIsActive(ctx context.Context, userID string, isActive bool) (User, error) {
+	ctx, span := tracing.Start(ctx, "user.Service.SetIsActive", attribute.String("user_id", userID))
+	defer span.End()
+
 	repoUser, err := s.repo.GetUser(ctx, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -25,6 +33,8 @@ func (s *Service) SetIsActive(ctx context.Context, userID string, isActive bool)
 
 	repoUser.IsActive = isActive
 
+	logging.FromContext(ctx).WithField("user_id", userID).WithField("is_active", isActive).Info("User activity changed")
+
 	user := User{}
 	user.FillFromDB(&repoUser)
 
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// UpdateUser меняет имя пользователя
+func (s *Service) UpdateUser(ctx context.Context, userID, username string) (User, error) {
+	ctx, span := tracing.Start(ctx, "user.Service.UpdateUser", attribute.String("user_id", userID))
+	defer span.End()
+
+	repoUser, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, err
+	}
+
+	if err := s.repo.UpdateUsername(ctx, userID, username); err != nil {
+		return User{}, err
+	}
+
+	repoUser.Username = username
+
+	user := User{}
+	user.FillFromDB(&repoUser)
+
+	return user, nil
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_UpdateUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         string
+		username       string
+		setupMock      func(*mockRepoForUser)
+		expectedError  error
+		validateResult func(*testing.T, User)
+	}{
+		{
+			name:     "successful rename",
+			userID:   "user-001",
+			username: "alice.smith",
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{
+					UserID:    "user-001",
+					Username:  "alice",
+					TeamName:  "backend",
+					TeamNames: []string{"backend"},
+					IsActive:  true,
+				}, nil)
+				m.On("UpdateUsername", mock.Anything, "user-001", "alice.smith").Return(nil)
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, u User) {
+				assert.Equal(t, "user-001", u.UserID)
+				assert.Equal(t, "alice.smith", u.Username)
+				assert.Equal(t, "backend", u.TeamName)
+			},
+		},
+		{
+			name:     "user not found",
+			userID:   "user-999",
+			username: "ghost",
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-999").Return(user.User{}, sql.ErrNoRows)
+			},
+			expectedError:  ErrUserNotFound,
+			validateResult: nil,
+		},
+		{
+			name:     "error updating username",
+			userID:   "user-001",
+			username: "alice.smith",
+			setupMock: func(m *mockRepoForUser) {
+				m.On("GetUser", mock.Anything, "user-001").Return(user.User{UserID: "user-001", Username: "alice"}, nil)
+				m.On("UpdateUsername", mock.Anything, "user-001", "alice.smith").Return(errors.New("update failed"))
+			},
+			expectedError:  errors.New("update failed"),
+			validateResult: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepoForUser)
+			tt.setupMock(mockRepo)
+
+			service := &Service{
+				repo: mockRepo,
+			}
+
+			result, err := service.UpdateUser(context.Background(), tt.userID, tt.username)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Equal(t, User{}, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.validateResult != nil {
+					tt.validateResult(t, result)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
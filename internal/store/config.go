@@ -1,9 +1,34 @@
 package store
 
+import "time"
+
 type Config struct {
 	DatabaseURL string `toml:"database_url"`
+
+	// Настройки пула соединений
+	MaxOpenConns    int           `toml:"max_open_conns"`
+	MaxIdleConns    int           `toml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `toml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `toml:"conn_max_idle_time"`
+
+	// Повторные попытки подключения при старте (БД может подниматься дольше сервиса)
+	ConnectAttempts   int           `toml:"connect_attempts"`
+	ConnectBackoff    time.Duration `toml:"connect_backoff"`
+	ConnectMaxBackoff time.Duration `toml:"connect_max_backoff"`
+
+	// QueryTimeout ограничивает время выполнения одного обращения репозитория к БД (0 - без ограничения)
+	QueryTimeout time.Duration `toml:"query_timeout"`
 }
 
 func NewConfig() *Config {
-	return &Config{}
+	return &Config{
+		MaxOpenConns:      25,
+		MaxIdleConns:      25,
+		ConnMaxLifetime:   30 * time.Minute,
+		ConnMaxIdleTime:   5 * time.Minute,
+		ConnectAttempts:   10,
+		ConnectBackoff:    500 * time.Millisecond,
+		ConnectMaxBackoff: 10 * time.Second,
+		QueryTimeout:      5 * time.Second,
+	}
 }
@@ -33,3 +33,12 @@ func DBErrToErr(err error) (res error, ok bool) {
 		return nil, false
 	}
 }
+
+// TranslateDBErr переводит нарушения ограничений БД в ErrForeignKeyViolated и ErrDuplicateKey
+// через DBErrToErr; остальные ошибки возвращаются без изменений
+func TranslateDBErr(err error) error {
+	if res, ok := DBErrToErr(err); ok {
+		return res
+	}
+	return err
+}
@@ -0,0 +1,10 @@
+package store
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike экранирует спецсимволы LIKE, чтобы строка искалась буквально
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -1,42 +1,137 @@
 package store
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
 
+	"github.com/aabbuukkaarr8/PRService/internal/tracing"
 	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type Store struct {
-	db *sql.DB
+	db           *sql.DB
+	queryTimeout time.Duration
 }
 
 func New() *Store {
 	return &Store{}
 }
 
-func (s *Store) Open(config string) error {
-	db, err := sql.Open("postgres", config)
+// Open открывает пул соединений и дожидается доступности БД,
+// повторяя попытки с экспоненциальной задержкой
+func (s *Store) Open(ctx context.Context, config *Config) error {
+	db, err := sql.Open("postgres", config.DatabaseURL)
 	if err != nil {
 		return err
 	}
 
-	if err := db.Ping(); err != nil {
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+
+	if err := ping(ctx, db, config); err != nil {
+		db.Close()
 		return err
 	}
+
 	s.db = db
+	s.queryTimeout = config.QueryTimeout
 	return nil
 }
 
+func ping(ctx context.Context, db *sql.DB, config *Config) error {
+	attempts := config.ConnectAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := config.ConnectBackoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = db.PingContext(ctx); err == nil {
+			return nil
+		}
+		if attempt == attempts {
+			break
+		}
+
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"attempt": attempt,
+			"backoff": backoff,
+		}).Warn("Database is not available yet, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if config.ConnectMaxBackoff > 0 && backoff > config.ConnectMaxBackoff {
+			backoff = config.ConnectMaxBackoff
+		}
+	}
+
+	return fmt.Errorf("database is not available after %d attempts: %w", attempts, err)
+}
+
+// WithQueryTimeout ограничивает контекст запроса к БД значением QueryTimeout из конфига
+// и открывает span запроса, названный по вызвавшему методу репозитория.
+// cancel завершает span; если запрос не уложился в таймаут, span помечается ошибкой
+func (s *Store) WithQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, span := tracing.StartDB(ctx, callerName())
+
+	var cancel context.CancelFunc
+	if s.queryTimeout <= 0 {
+		ctx, cancel = context.WithCancel(ctx)
+	} else {
+		ctx, cancel = context.WithTimeout(ctx, s.queryTimeout)
+	}
+
+	return ctx, func() {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			span.SetStatus(codes.Error, "query timeout exceeded")
+		}
+		cancel()
+		span.End()
+	}
+}
+
+// callerName возвращает имя метода, вызвавшего WithQueryTimeout, в виде "pullrequest.Repository.MergePullRequest"
+func callerName() string {
+	pc, _, _, ok := runtime.Caller(2)
+	if !ok {
+		return "store.query"
+	}
+	name := runtime.FuncForPC(pc).Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	return strings.NewReplacer("(*", "", ")", "").Replace(name)
+}
+
 func (s *Store) GetConn() *sql.DB {
 	return s.db
 }
 
-func (s *Store) Close() {
-	err := s.db.Close()
-	if err != nil {
-		return
+func (s *Store) Close() error {
+	if s.db == nil {
+		return nil
 	}
+	return s.db.Close()
 }
+
 func (s *Store) SetConn(db *sql.DB) {
 	s.db = db
 }
+
+// SetQueryTimeout задает таймаут запросов для соединения, установленного через SetConn
+func (s *Store) SetQueryTimeout(timeout time.Duration) {
+	s.queryTimeout = timeout
+}
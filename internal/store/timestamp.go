@@ -0,0 +1,22 @@
+package store
+
+import "time"
+
+// Колонки TIMESTAMP (created_at, merged_at у PR) хранят время без часового пояса, в локальном
+// времени сервиса. Драйвер помечает прочитанные значения как UTC, а у параметров отбрасывает пояс,
+// поэтому время переводится в локальное на входе и на выходе репозиториев.
+
+// FromTimestamp возвращает прочитанное из колонки TIMESTAMP время в локальном поясе сервиса:
+// часы и минуты сохраняются, пояс UTC заменяется на локальный
+func FromTimestamp(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local)
+	return &local
+}
+
+// ToTimestamp переводит время в локальный пояс сервиса перед сравнением с колонкой TIMESTAMP
+func ToTimestamp(t time.Time) time.Time {
+	return t.In(time.Local)
+}
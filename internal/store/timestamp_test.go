@@ -0,0 +1,25 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestamp_RoundTrip(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC+3", 3*60*60)
+	defer func() { time.Local = local }()
+
+	// драйвер вернул записанные в 12:00 по локальному времени часы с пометкой UTC
+	scanned := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+	got := FromTimestamp(&scanned)
+
+	assert.Equal(t, time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC), got.UTC())
+	assert.Nil(t, FromTimestamp(nil))
+
+	// клиент вернул полученное время в UTC: параметр должен снова дать 12:00 по локальному времени
+	param := ToTimestamp(got.UTC())
+	assert.Equal(t, "2025-11-01 12:00:00", param.Format(time.DateTime))
+}
@@ -0,0 +1,28 @@
+package tracing
+
+const (
+	// ExporterNone трассировка выключена: span'ы не создаются и никуда не отправляются
+	ExporterNone = "none"
+	// ExporterStdout span'ы печатаются в stdout в JSON, удобно для локальной отладки
+	ExporterStdout = "stdout"
+	// ExporterOTLP span'ы отправляются по OTLP/HTTP в коллектор (Jaeger, Tempo, otel-collector)
+	ExporterOTLP = "otlp"
+)
+
+type Config struct {
+	Exporter    string  `toml:"exporter"`
+	Endpoint    string  `toml:"endpoint"`
+	Insecure    bool    `toml:"insecure"`
+	ServiceName string  `toml:"service_name"`
+	SampleRatio float64 `toml:"sample_ratio"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Exporter:    ExporterNone,
+		Endpoint:    "localhost:4318",
+		Insecure:    true,
+		ServiceName: "pr-reviewer",
+		SampleRatio: 1,
+	}
+}
@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		name          string
+		exporter      string
+		expectedError string
+	}{
+		{name: "disabled", exporter: ExporterNone},
+		{name: "stdout", exporter: ExporterStdout},
+		{name: "otlp", exporter: ExporterOTLP},
+		{name: "unknown exporter", exporter: "zipkin", expectedError: `unknown tracing exporter "zipkin"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := NewConfig()
+			config.Exporter = tt.exporter
+
+			shutdown, err := Setup(context.Background(), config)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, shutdown(context.Background()))
+		})
+	}
+}
+
+func TestSetup_PropagatesTraceContext(t *testing.T) {
+	config := NewConfig()
+	config.Exporter = ExporterStdout
+	shutdown, err := Setup(context.Background(), config)
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	carrier := propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := Start(ctx, "child")
+	defer span.End()
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+}
@@ -0,0 +1,21 @@
+package validation
+
+// Config правила проверки тел запросов
+type Config struct {
+	// IDPattern регулярное выражение для user_id, pull_request_id и других идентификаторов
+	IDPattern   string `toml:"id_pattern"`
+	IDMaxLength int    `toml:"id_max_length"`
+	// NameMaxLength максимальная длина имен (team_name, username, pull_request_name) в символах
+	NameMaxLength int `toml:"name_max_length"`
+	// MaxTeamMembers сколько участников можно передать в одном запросе /team/add и /team/addMembers
+	MaxTeamMembers int `toml:"max_team_members"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		IDPattern:      `^[A-Za-z0-9._-]+$`,
+		IDMaxLength:    64,
+		NameMaxLength:  255,
+		MaxTeamMembers: 100,
+	}
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError ошибка проверки одного поля запроса
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// FieldErrors переводит ошибки валидатора в список полей с путями как в JSON (members[1].user_id);
+// для других ошибок (например, синтаксических ошибок JSON) возвращает nil
+func FieldErrors(err error) []FieldError {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+
+	result := make([]FieldError, len(errs))
+	for i, fe := range errs {
+		result[i] = FieldError{
+			Field:   fieldPath(fe),
+			Message: message(fe),
+		}
+	}
+	return result
+}
+
+// fieldPath убирает из пути имя типа запроса
+func fieldPath(fe validator.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
+
+func message(fe validator.FieldError) string {
+	r := current.Load()
+
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case TagID:
+		return fmt.Sprintf("must be at most %d characters and match %s", r.config.IDMaxLength, r.config.IDPattern)
+	case TagName:
+		return fmt.Sprintf("must be 1-%d characters without leading or trailing spaces", r.config.NameMaxLength)
+	case TagMaxMembers:
+		return fmt.Sprintf("must contain at most %d members", r.config.MaxTeamMembers)
+	case "unique":
+		return uniqueMessage(fe)
+	case "oneof":
+		return "must be one of: " + fe.Param()
+	case "min":
+		return fmt.Sprintf("must contain at least %s %s", fe.Param(), unit(fe.Kind()))
+	case "max":
+		return fmt.Sprintf("must contain at most %s %s", fe.Param(), unit(fe.Kind()))
+	default:
+		return fmt.Sprintf("failed %q check", fe.Tag())
+	}
+}
+
+func unit(kind reflect.Kind) string {
+	if kind == reflect.String {
+		return "characters"
+	}
+	return "items"
+}
+
+// uniqueMessage называет первое повторяющееся значение; для unique=Field сравнивается поле элементов
+func uniqueMessage(fe validator.FieldError) string {
+	list := reflect.ValueOf(fe.Value())
+	if list.Kind() != reflect.Slice {
+		return "must not contain duplicates"
+	}
+
+	name := "value"
+	if fe.Param() != "" {
+		if field, ok := list.Type().Elem().FieldByName(fe.Param()); ok {
+			name = jsonName(field)
+		}
+	}
+
+	seen := make(map[any]bool, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		item := list.Index(i)
+		if fe.Param() != "" {
+			item = item.FieldByName(fe.Param())
+		}
+		value := item.Interface()
+		if seen[value] {
+			return fmt.Sprintf("must not contain duplicate %s %v", name, value)
+		}
+		seen[value] = true
+	}
+	return "must not contain duplicate " + name
+}
@@ -0,0 +1,101 @@
+// Package validation правила проверки тел запросов для binding-тегов gin.
+// Правила регистрируются в валидаторе gin при импорте пакета с настройками по умолчанию,
+// Configure заменяет их настройками из конфига
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Теги правил для использования в binding
+const (
+	// TagID идентификатор: шаблон IDPattern и не длиннее IDMaxLength
+	TagID = "id"
+	// TagName имя: непустое, без пробелов по краям и управляющих символов, не длиннее NameMaxLength
+	TagName = "name"
+	// TagMaxMembers список участников не длиннее MaxTeamMembers
+	TagMaxMembers = "maxmembers"
+)
+
+type rules struct {
+	config    Config
+	idPattern *regexp.Regexp
+}
+
+var current atomic.Pointer[rules]
+
+func init() {
+	if err := Configure(NewConfig()); err != nil {
+		panic(err)
+	}
+
+	engine, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		panic("validation: gin validator is not go-playground/validator")
+	}
+	engine.RegisterTagNameFunc(jsonName)
+	for tag, fn := range map[string]validator.Func{
+		TagID:         validateID,
+		TagName:       validateName,
+		TagMaxMembers: validateMaxMembers,
+	} {
+		if err := engine.RegisterValidation(tag, fn); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Configure применяет правила; вызывается при старте до обработки запросов
+func Configure(cfg *Config) error {
+	idPattern, err := regexp.Compile(cfg.IDPattern)
+	if err != nil {
+		return fmt.Errorf("validation: id_pattern: %w", err)
+	}
+	if cfg.IDMaxLength <= 0 || cfg.NameMaxLength <= 0 || cfg.MaxTeamMembers <= 0 {
+		return errors.New("validation: id_max_length, name_max_length and max_team_members must be positive")
+	}
+
+	current.Store(&rules{config: *cfg, idPattern: idPattern})
+	return nil
+}
+
+func validateID(fl validator.FieldLevel) bool {
+	r := current.Load()
+	value := fl.Field().String()
+	return utf8.RuneCountInString(value) <= r.config.IDMaxLength && r.idPattern.MatchString(value)
+}
+
+func validateName(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if value == "" || strings.TrimSpace(value) != value {
+		return false
+	}
+	if utf8.RuneCountInString(value) > current.Load().config.NameMaxLength {
+		return false
+	}
+	return strings.IndexFunc(value, unicode.IsControl) < 0
+}
+
+func validateMaxMembers(fl validator.FieldLevel) bool {
+	return fl.Field().Len() <= current.Load().config.MaxTeamMembers
+}
+
+// jsonName называет поля в ошибках так же, как в теле запроса: по тегу json, а для ростера - yaml
+func jsonName(field reflect.StructField) string {
+	for _, key := range []string{"json", "yaml"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
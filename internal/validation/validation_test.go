@@ -0,0 +1,158 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMember struct {
+	UserID   string `json:"user_id" binding:"required,id"`
+	Username string `json:"username" binding:"required,name"`
+}
+
+type testRequest struct {
+	TeamName string       `json:"team_name" binding:"required,name"`
+	Members  []testMember `json:"members" binding:"required,maxmembers,unique=UserID,dive"`
+	UserIDs  []string     `json:"user_ids" binding:"omitempty,unique,dive,id"`
+}
+
+type testRosterTeam struct {
+	TeamName string `yaml:"team_name" binding:"required,name"`
+}
+
+func TestFieldErrors(t *testing.T) {
+	member := testMember{UserID: "u1", Username: "alice"}
+
+	tests := []struct {
+		name     string
+		request  any
+		expected []FieldError
+	}{
+		{
+			name:    "valid request",
+			request: testRequest{TeamName: "backend", Members: []testMember{member}},
+		},
+		{
+			name:    "invalid member fields",
+			request: testRequest{TeamName: "backend", Members: []testMember{member, {UserID: "u/2", Username: " bob"}}},
+			expected: []FieldError{
+				{Field: "members[1].user_id", Message: "must be at most 64 characters and match ^[A-Za-z0-9._-]+$"},
+				{Field: "members[1].username", Message: "must be 1-255 characters without leading or trailing spaces"},
+			},
+		},
+		{
+			name:    "duplicate user_id in members",
+			request: testRequest{TeamName: "backend", Members: []testMember{member, {UserID: "u2", Username: "bob"}, member}},
+			expected: []FieldError{
+				{Field: "members", Message: "must not contain duplicate user_id u1"},
+			},
+		},
+		{
+			name:    "duplicate plain values",
+			request: testRequest{TeamName: "backend", Members: []testMember{member}, UserIDs: []string{"u1", "u2", "u2"}},
+			expected: []FieldError{
+				{Field: "user_ids", Message: "must not contain duplicate value u2"},
+			},
+		},
+		{
+			name:    "too long id and name",
+			request: testRequest{TeamName: strings.Repeat("t", 256), Members: []testMember{{UserID: strings.Repeat("u", 65), Username: "alice"}}},
+			expected: []FieldError{
+				{Field: "team_name", Message: "must be 1-255 characters without leading or trailing spaces"},
+				{Field: "members[0].user_id", Message: "must be at most 64 characters and match ^[A-Za-z0-9._-]+$"},
+			},
+		},
+		{
+			name:    "control characters in name",
+			request: testRequest{TeamName: "back\nend", Members: []testMember{member}},
+			expected: []FieldError{
+				{Field: "team_name", Message: "must be 1-255 characters without leading or trailing spaces"},
+			},
+		},
+		{
+			name:    "missing fields",
+			request: testRequest{},
+			expected: []FieldError{
+				{Field: "team_name", Message: "is required"},
+				{Field: "members", Message: "is required"},
+			},
+		},
+		{
+			name:    "yaml field names",
+			request: testRosterTeam{},
+			expected: []FieldError{
+				{Field: "team_name", Message: "is required"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := binding.Validator.ValidateStruct(tt.request)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, FieldErrors(err))
+		})
+	}
+}
+
+func TestFieldErrors_NotValidationError(t *testing.T) {
+	assert.Nil(t, FieldErrors(errors.New("unexpected EOF")))
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, Configure(NewConfig()))
+	})
+
+	require.NoError(t, Configure(&Config{
+		IDPattern:      `^u[0-9]+$`,
+		IDMaxLength:    3,
+		NameMaxLength:  5,
+		MaxTeamMembers: 1,
+	}))
+
+	err := binding.Validator.ValidateStruct(testRequest{
+		TeamName: "backend",
+		Members:  []testMember{{UserID: "u1", Username: "alice"}, {UserID: "u2", Username: "bob"}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, []FieldError{
+		{Field: "team_name", Message: "must be 1-5 characters without leading or trailing spaces"},
+		{Field: "members", Message: "must contain at most 1 members"},
+	}, FieldErrors(err))
+
+	err = binding.Validator.ValidateStruct(testMember{UserID: "user1", Username: "alice"})
+	require.Error(t, err)
+	assert.Equal(t, []FieldError{
+		{Field: "user_id", Message: "must be at most 3 characters and match ^u[0-9]+$"},
+	}, FieldErrors(err))
+}
+
+func TestConfigure_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+	}{
+		{name: "bad pattern", modify: func(cfg *Config) { cfg.IDPattern = "[" }},
+		{name: "zero id length", modify: func(cfg *Config) { cfg.IDMaxLength = 0 }},
+		{name: "negative name length", modify: func(cfg *Config) { cfg.NameMaxLength = -1 }},
+		{name: "zero max members", modify: func(cfg *Config) { cfg.MaxTeamMembers = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig()
+			tt.modify(cfg)
+			assert.Error(t, Configure(cfg))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package openapi встраивает спецификацию API в бинарник
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec спецификация openapi 2.yml в исходном YAML
+//
+//go:embed "openapi 2.yml"
+var Spec []byte
+
+// JSON возвращает спецификацию, преобразованную в JSON
+func JSON() ([]byte, error) {
+	var doc any
+	if err := yaml.Unmarshal(Spec, &doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
@@ -0,0 +1,22 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestJSON(t *testing.T) {
+	data, err := JSON()
+	require.NoError(t, err)
+
+	var fromJSON, fromYAML map[string]any
+	require.NoError(t, json.Unmarshal(data, &fromJSON))
+	require.NoError(t, yaml.Unmarshal(Spec, &fromYAML))
+
+	assert.Equal(t, fromYAML["openapi"], fromJSON["openapi"])
+	assert.Len(t, fromJSON["paths"], len(fromYAML["paths"].(map[string]any)))
+}
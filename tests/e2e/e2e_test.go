@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -11,13 +12,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aabbuukkaarr8/PRService/db"
 	"github.com/aabbuukkaarr8/PRService/internal/apiserver"
+	ownersHandler "github.com/aabbuukkaarr8/PRService/internal/handler/owners"
 	pullrequestsHandler "github.com/aabbuukkaarr8/PRService/internal/handler/pullrequest"
 	teamHandler "github.com/aabbuukkaarr8/PRService/internal/handler/team"
 	usersHandler "github.com/aabbuukkaarr8/PRService/internal/handler/user"
+	"github.com/aabbuukkaarr8/PRService/internal/migrate"
+	"github.com/aabbuukkaarr8/PRService/internal/repository/owners"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/pullrequest"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/team"
 	"github.com/aabbuukkaarr8/PRService/internal/repository/user"
+	ownersService "github.com/aabbuukkaarr8/PRService/internal/service/owners"
 	pullrequestsService "github.com/aabbuukkaarr8/PRService/internal/service/pullrequest"
 	teamService "github.com/aabbuukkaarr8/PRService/internal/service/team"
 	usersService "github.com/aabbuukkaarr8/PRService/internal/service/user"
@@ -56,13 +62,13 @@ func setup() {
 		panic(fmt.Sprintf("Failed to ping test database: %v. Make sure PostgreSQL is running and database exists", err))
 	}
 
-	if err := runMigrations(testDB); err != nil {
-		panic(fmt.Sprintf("Failed to run migrations: %v", err))
-	}
-
 	testStore = store.New()
 	testStore.SetConn(testDB)
 
+	if err := runMigrations(testStore); err != nil {
+		panic(fmt.Sprintf("Failed to run migrations: %v", err))
+	}
+
 	config := apiserver.NewConfig()
 	config.BindAddr = ":0"
 	config.LogLevel = "error"
@@ -70,10 +76,12 @@ func setup() {
 	teamRepo := team.NewRepository(testStore)
 	userRepo := user.NewRepository(testStore)
 	prRepo := pullrequest.NewRepository(testStore)
+	ownersRepo := owners.NewRepository(testStore)
 
 	teamSrv := teamService.NewService(teamRepo)
 	userSrv := usersService.NewService(userRepo)
-	prSrv := pullrequestsService.NewService(prRepo)
+	ownersSrv := ownersService.NewService(ownersRepo)
+	prSrv := pullrequestsService.NewService(prRepo, ownersSrv)
 
 	logger := logrus.New()
 	logger.SetLevel(logrus.ErrorLevel)
@@ -81,9 +89,10 @@ func setup() {
 	teamHndlr := teamHandler.NewHandler(teamSrv, logger)
 	userHndlr := usersHandler.NewHandler(userSrv, logger)
 	prHndlr := pullrequestsHandler.NewHandler(prSrv, logger)
+	ownersHndlr := ownersHandler.NewHandler(ownersSrv, logger)
 
 	s := apiserver.New(config)
-	s.ConfigureRouter(teamHndlr, userHndlr, prHndlr)
+	s.ConfigureRouter(teamHndlr, userHndlr, prHndlr, ownersHndlr)
 
 	testServer = httptest.NewServer(s.GetRouter())
 }
@@ -98,43 +107,17 @@ func teardown() {
 	}
 }
 
-func runMigrations(db *sql.DB) error {
-	migrations := []string{
-		`CREATE TABLE IF NOT EXISTS teams (
-			team_name TEXT PRIMARY KEY
-		)`,
-		`CREATE TABLE IF NOT EXISTS users (
-			user_id TEXT PRIMARY KEY,
-			username TEXT NOT NULL,
-			team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
-			is_active BOOLEAN NOT NULL DEFAULT TRUE
-		)`,
-		`CREATE INDEX IF NOT EXISTS idx_users_team_name ON users(team_name)`,
-		`CREATE INDEX IF NOT EXISTS idx_users_is_active ON users(is_active)`,
-		`CREATE TABLE IF NOT EXISTS pullrequests (
-			pull_request_id TEXT PRIMARY KEY,
-			pull_request_name TEXT NOT NULL,
-			author_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
-			status TEXT NOT NULL CHECK (status IN ('OPEN', 'MERGED')),
-			assigned_reviewers TEXT[] DEFAULT '{}',
-			created_at TIMESTAMP,
-			merged_at TIMESTAMP
-		)`,
-		`CREATE INDEX IF NOT EXISTS idx_pullrequests_author_id ON pullrequests(author_id)`,
-		`CREATE INDEX IF NOT EXISTS idx_pullrequests_status ON pullrequests(status)`,
-		`CREATE INDEX IF NOT EXISTS idx_pullrequests_assigned_reviewers ON pullrequests USING GIN(assigned_reviewers)`,
-	}
-
-	for _, migration := range migrations {
-		if _, err := db.Exec(migration); err != nil {
-			return fmt.Errorf("migration failed: %w", err)
-		}
+func runMigrations(s *store.Store) error {
+	migrator, err := migrate.New(s, db.Migrations())
+	if err != nil {
+		return err
 	}
-	return nil
+	_, err = migrator.Up(context.Background())
+	return err
 }
 
 func cleanupDatabase(db *sql.DB) {
-	tables := []string{"pullrequests", "users", "teams"}
+	tables := []string{"ownership_rules", "user_expertise", "pullrequests", "team_members", "users", "teams"}
 	for _, table := range tables {
 		db.Exec(fmt.Sprintf("TRUNCATE TABLE %s CASCADE", table))
 	}
@@ -154,7 +137,7 @@ func TestE2E_CreateTeamAndGetTeam(t *testing.T) {
 	}
 
 	body, _ := json.Marshal(teamData)
-	req, _ := http.NewRequest("POST", testServer.URL+"/team/add", bytes.NewBuffer(body))
+	req, _ := http.NewRequest("POST", testServer.URL+"/api/v1/team/add", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := client.Do(req)
 	if err != nil {
@@ -166,7 +149,7 @@ func TestE2E_CreateTeamAndGetTeam(t *testing.T) {
 		t.Errorf("Expected status 201, got %d", resp.StatusCode)
 	}
 
-	req2, _ := http.NewRequest("GET", testServer.URL+"/team/get?team_name=backend", nil)
+	req2, _ := http.NewRequest("GET", testServer.URL+"/api/v1/team/get?team_name=backend", nil)
 	resp2, err := client.Do(req2)
 	if err != nil {
 		t.Fatalf("Failed to make request: %v", err)
@@ -207,7 +190,7 @@ func TestE2E_CreatePR(t *testing.T) {
 	client := &http.Client{Timeout: 5 * time.Second}
 
 	body, _ := json.Marshal(teamData)
-	req, _ := http.NewRequest("POST", testServer.URL+"/team/add", bytes.NewBuffer(body))
+	req, _ := http.NewRequest("POST", testServer.URL+"/api/v1/team/add", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := client.Do(req)
 	if err != nil {
@@ -222,7 +205,7 @@ func TestE2E_CreatePR(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(prData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/pullRequest/create", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/pullRequest/create", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err = client.Do(req)
 	if err != nil {
@@ -272,7 +255,7 @@ func TestE2E_MergePR(t *testing.T) {
 	}
 
 	body, _ := json.Marshal(teamData)
-	req, _ := http.NewRequest("POST", testServer.URL+"/team/add", bytes.NewBuffer(body))
+	req, _ := http.NewRequest("POST", testServer.URL+"/api/v1/team/add", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, _ := client.Do(req)
 	resp.Body.Close()
@@ -284,7 +267,7 @@ func TestE2E_MergePR(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(prData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/pullRequest/create", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/pullRequest/create", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, _ = client.Do(req)
 	resp.Body.Close()
@@ -294,7 +277,7 @@ func TestE2E_MergePR(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(mergeData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/pullRequest/merge", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/pullRequest/merge", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := client.Do(req)
 	if err != nil {
@@ -334,7 +317,7 @@ func TestE2E_SetUserActive(t *testing.T) {
 	}
 
 	body, _ := json.Marshal(teamData)
-	req, _ := http.NewRequest("POST", testServer.URL+"/team/add", bytes.NewBuffer(body))
+	req, _ := http.NewRequest("POST", testServer.URL+"/api/v1/team/add", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, _ := client.Do(req)
 	resp.Body.Close()
@@ -345,7 +328,7 @@ func TestE2E_SetUserActive(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(userData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/users/setIsActive", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/users/setIsActive", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := client.Do(req)
 	if err != nil {
@@ -386,7 +369,7 @@ func TestE2E_GetUserReviews(t *testing.T) {
 	}
 
 	body, _ := json.Marshal(teamData)
-	req, _ := http.NewRequest("POST", testServer.URL+"/team/add", bytes.NewBuffer(body))
+	req, _ := http.NewRequest("POST", testServer.URL+"/api/v1/team/add", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, _ := client.Do(req)
 	resp.Body.Close()
@@ -398,12 +381,12 @@ func TestE2E_GetUserReviews(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(prData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/pullRequest/create", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/pullRequest/create", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, _ = client.Do(req)
 	resp.Body.Close()
 
-	req, _ = http.NewRequest("GET", testServer.URL+"/users/getReview?user_id=u2", nil)
+	req, _ = http.NewRequest("GET", testServer.URL+"/api/v1/users/getReview?user_id=u2", nil)
 	resp, err := client.Do(req)
 	if err != nil {
 		t.Fatalf("Failed to get user reviews: %v", err)
@@ -440,7 +423,7 @@ func TestE2E_ReassignReviewer(t *testing.T) {
 	}
 
 	body, _ := json.Marshal(teamData)
-	req, _ := http.NewRequest("POST", testServer.URL+"/team/add", bytes.NewBuffer(body))
+	req, _ := http.NewRequest("POST", testServer.URL+"/api/v1/team/add", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, _ := client.Do(req)
 	resp.Body.Close()
@@ -452,7 +435,7 @@ func TestE2E_ReassignReviewer(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(prData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/pullRequest/create", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/pullRequest/create", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := client.Do(req)
 	if err != nil {
@@ -487,7 +470,7 @@ func TestE2E_ReassignReviewer(t *testing.T) {
 	}
 
 	body, _ = json.Marshal(reassignData)
-	req, _ = http.NewRequest("POST", testServer.URL+"/pullRequest/reassign", bytes.NewBuffer(body))
+	req, _ = http.NewRequest("POST", testServer.URL+"/api/v1/pullRequest/reassign", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	resp, err = client.Do(req)
 	if err != nil {